@@ -0,0 +1,112 @@
+package ecs
+
+// Tick is a point in time used for change detection. The World starts at tick
+// 1, so changes are always newer than tick 0.
+type Tick uint32
+
+// Filter reports whether an entity satisfies a condition. Filters can be
+// combined with any query using Where.
+type Filter func(e Entity) bool
+
+// Tick returns the current tick of the world. Additions, changes, and removals
+// are recorded with the current tick.
+func (w *World) Tick() Tick {
+	return *w.tick
+}
+
+// Advance moves the world to the next tick and returns it.
+//
+// A system typically remembers the tick it last ran at and asks for changes
+// made since. Advance once per update, or between systems for finer detail.
+func (w *World) Advance() Tick {
+	*w.tick++
+	return *w.tick
+}
+
+// ClearRemovals throws away the log of removed components in every store.
+//
+// Removals are logged until cleared. Call this once every system has had the
+// chance to observe them, such as at the end of each update.
+func (w *World) ClearRemovals() {
+	for _, id := range w.initialized {
+		if r, ok := w.components[id].(interface{ ClearRemovals() }); ok {
+			r.ClearRemovals()
+		}
+	}
+}
+
+// Where returns the entities which satisfy every filter.
+//
+// Time Complexity: O(N) where N = # Entities
+func Where(es []Entity, filters ...Filter) []Entity {
+	matches := make([]Entity, 0)
+outer:
+	for _, e := range es {
+		for _, f := range filters {
+			if !f(e) {
+				continue outer
+			}
+		}
+		matches = append(matches, e)
+	}
+	return matches
+}
+
+// MarkChanged records that the component of an entity was changed and notifies
+// observers. Use it after writing through slices from Query, pointers from
+// iterators, or GetMut, which are not observed.
+func MarkChanged[T any](w *World, e Entity) bool {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return false
+	}
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	return alive && store.MarkChanged(e)
+}
+
+// Added creates a filter for entities whose component was added after the
+// given tick.
+func Added[T any](w *World, since Tick) Filter {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return func(e Entity) bool { return false }
+	}
+	return func(e Entity) bool {
+		return store.AddedSince(e, since)
+	}
+}
+
+// Changed creates a filter for entities whose component was added or changed
+// after the given tick.
+func Changed[T any](w *World, since Tick) Filter {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return func(e Entity) bool { return false }
+	}
+	return func(e Entity) bool {
+		return store.ChangedSince(e, since)
+	}
+}
+
+// Removed creates a filter for entities whose component was removed after the
+// given tick.
+func Removed[T any](w *World, since Tick) Filter {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return func(e Entity) bool { return false }
+	}
+	return func(e Entity) bool {
+		return store.RemovedSince(e, since)
+	}
+}
+
+// Removals returns the entities whose component was removed after the given
+// tick, including entities which were destroyed.
+func Removals[T any](w *World, since Tick) []Entity {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return []Entity{}
+	}
+	return store.Removals(since)
+}
@@ -0,0 +1,84 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestChangeDetection(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+
+	player := world.NewEntity()
+	npc := world.NewEntity()
+	ecs.Add(&world, player, Position{})
+	ecs.Add(&world, player, Velocity{})
+	ecs.Add(&world, npc, Position{})
+	ecs.Add(&world, npc, Velocity{})
+	ecs.Add(&world, npc, Health{3})
+
+	last := world.Tick()
+	world.Advance()
+
+	t.Run("Added", func(t *testing.T) {
+		es := ecs.Where(ecs.Query2[Position, Velocity](&world), ecs.Added[Position](&world, 0))
+		testutil.AssertEqual(t, len(es), 2)
+		es = ecs.Where(ecs.Query2[Position, Velocity](&world), ecs.Added[Position](&world, last))
+		testutil.AssertEqual(t, len(es), 0)
+
+		ecs.Add(&world, player, Health{1})
+		es, _ = ecs.Query[Health](&world)
+		es = ecs.Where(es, ecs.Added[Health](&world, last))
+		testutil.AssertEqual(t, len(es), 1)
+		testutil.AssertEqual(t, es[0], player)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		es := ecs.Where(ecs.Query2[Position, Velocity](&world), ecs.Changed[Position](&world, last))
+		testutil.AssertEqual(t, len(es), 0)
+
+		p, _ := ecs.GetMut[Position](&world, npc)
+		p.x = 1.0
+		es = ecs.Where(ecs.Query2[Position, Velocity](&world), ecs.Changed[Position](&world, last))
+		testutil.AssertEqual(t, len(es), 1)
+		testutil.AssertEqual(t, es[0], npc)
+
+		_, vs := ecs.Query[Velocity](&world)
+		vs[0].x = 1.0
+		testutil.AssertEqual(t, ecs.MarkChanged[Velocity](&world, player), true)
+		es = ecs.Where(ecs.Query2[Position, Velocity](&world),
+			ecs.Changed[Position](&world, last),
+			ecs.Changed[Velocity](&world, last))
+		testutil.AssertEqual(t, len(es), 0)
+		es = ecs.Where(ecs.Query2[Position, Velocity](&world), ecs.Changed[Velocity](&world, last))
+		testutil.AssertEqual(t, len(es), 1)
+		testutil.AssertEqual(t, es[0], player)
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		ecs.Remove[Health](&world, npc)
+		es := ecs.Where(ecs.Query2[Position, Velocity](&world), ecs.Removed[Health](&world, last))
+		testutil.AssertEqual(t, len(es), 1)
+		testutil.AssertEqual(t, es[0], npc)
+
+		world.DestroyEntity(player)
+		removals := ecs.Removals[Health](&world, last)
+		testutil.AssertEqual(t, len(removals), 2)
+		testutil.AssertEqual(t, len(ecs.Removals[Position](&world, last)), 1)
+
+		now := world.Tick()
+		world.Advance()
+		testutil.AssertEqual(t, len(ecs.Removals[Health](&world, now)), 0)
+
+		world.ClearRemovals()
+		testutil.AssertEqual(t, len(ecs.Removals[Health](&world, 0)), 0)
+	})
+}
@@ -0,0 +1,96 @@
+package ecs
+
+// CommandBuffer records structural changes so they can be replayed on the
+// World at a sync point. Recording is safe while iterating over a query since
+// nothing is applied until Flush.
+//
+// Entities created through the buffer are placeholders. A placeholder is a
+// real entity whose ID is reserved in the world when it is recorded, so it may
+// be the target of any recorded command. It is not alive until the buffer is
+// flushed, and its ID is handed back if the buffer is reset instead. A buffer
+// must only be used with a single world.
+type CommandBuffer struct {
+	commands []command
+
+	// entities is the entity manager of the world in which placeholders are
+	// reserved, or nil if there are none.
+	entities *entityManager
+
+	// reserved lists the placeholders recorded with NewEntity, so that Reset
+	// can hand back those which were not brought to life.
+	reserved []Entity
+}
+
+// command is a single recorded change replayed on the World.
+type command func(w *World)
+
+// NewCommandBuffer creates an empty command buffer.
+func NewCommandBuffer() *CommandBuffer {
+	return &CommandBuffer{
+		commands: make([]command, 0),
+		reserved: make([]Entity, 0),
+	}
+}
+
+// NewEntity records the creation of an entity and returns its placeholder.
+//
+// The null entity is returned when the world is full.
+func (cb *CommandBuffer) NewEntity(w *World) Entity {
+	placeholder := w.entities.Reserve()
+	if placeholder == 0 {
+		return 0
+	}
+	cb.entities = w.entities
+	cb.reserved = append(cb.reserved, placeholder)
+	cb.commands = append(cb.commands, func(w *World) {
+		w.entities.Revive(placeholder)
+	})
+	return placeholder
+}
+
+// DestroyEntity records the destruction of an entity or placeholder.
+func (cb *CommandBuffer) DestroyEntity(e Entity) {
+	cb.commands = append(cb.commands, func(w *World) {
+		w.DestroyEntity(e)
+	})
+}
+
+// AddDeferred records adding a component to an entity or placeholder.
+func AddDeferred[T any](cb *CommandBuffer, e Entity, c T) {
+	cb.commands = append(cb.commands, func(w *World) {
+		Add(w, e, c)
+	})
+}
+
+// RemoveDeferred records removing a component from an entity or placeholder.
+func RemoveDeferred[T any](cb *CommandBuffer, e Entity) {
+	cb.commands = append(cb.commands, func(w *World) {
+		Remove[T](w, e)
+	})
+}
+
+// Flush replays every recorded command on the world in the order recorded
+// and then empties the buffer. Commands which fail, such as adding to a dead
+// entity, are skipped.
+func (cb *CommandBuffer) Flush(w *World) {
+	for _, cmd := range cb.commands {
+		cmd(w)
+	}
+	cb.Reset()
+}
+
+// Reset discards every recorded command without applying them. The IDs of
+// placeholders which were not brought to life are handed back to the world.
+func (cb *CommandBuffer) Reset() {
+	for _, e := range cb.reserved {
+		cb.entities.Unreserve(e)
+	}
+	clear(cb.commands)
+	cb.commands = cb.commands[:0]
+	cb.reserved = cb.reserved[:0]
+}
+
+// Len returns the number of recorded commands.
+func (cb *CommandBuffer) Len() int {
+	return len(cb.commands)
+}
@@ -0,0 +1,112 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestCommandBuffer(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[DeadTag](&world)
+
+	for i := 0; i < 8; i++ {
+		e := world.NewEntity()
+		ecs.Add(&world, e, Health{i % 2})
+		ecs.Add(&world, e, Position{float32(i), 0.0, 0.0})
+	}
+
+	cb := ecs.NewCommandBuffer()
+
+	t.Run("RemoveDuringQuery", func(t *testing.T) {
+		es, hs := ecs.Query[Health](&world)
+		for i, e := range es {
+			if hs[i].hp == 0 {
+				ecs.RemoveDeferred[Health](cb, e)
+				ecs.AddDeferred(cb, e, DeadTag{})
+			}
+		}
+		testutil.AssertEqual(t, cb.Len(), 8)
+		testutil.AssertEqual(t, len(es), 8)
+
+		cb.Flush(&world)
+		testutil.AssertEqual(t, cb.Len(), 0)
+
+		es, hs = ecs.Query[Health](&world)
+		testutil.AssertEqual(t, len(es), 4)
+		for _, h := range hs {
+			testutil.AssertEqual(t, h.hp, 1)
+		}
+		dead, _ := ecs.Query[DeadTag](&world)
+		testutil.AssertEqual(t, len(dead), 4)
+	})
+
+	t.Run("Placeholder", func(t *testing.T) {
+		count := world.EntityCount()
+		spawned := cb.NewEntity(&world)
+		ecs.AddDeferred(cb, spawned, Health{7})
+		ecs.AddDeferred(cb, spawned, Position{1.0, 1.0, 1.0})
+		testutil.AssertEqual(t, world.IsAlive(spawned), false)
+		testutil.AssertEqual(t, world.EntityCount(), count)
+
+		cb.Flush(&world)
+		testutil.AssertEqual(t, world.EntityCount(), count+1)
+
+		es := ecs.Query2[Health, Position](&world)
+		found := false
+		for _, e := range es {
+			if hp, _ := ecs.Get[Health](&world, e); hp.hp == 7 {
+				found = true
+			}
+		}
+		testutil.AssertEqual(t, found, true)
+	})
+
+	t.Run("DestroyEntity", func(t *testing.T) {
+		es, _ := ecs.Query[DeadTag](&world)
+		for _, e := range es {
+			cb.DestroyEntity(e)
+		}
+		count := world.EntityCount()
+		cb.Flush(&world)
+		testutil.AssertEqual(t, world.EntityCount(), count-4)
+		es, _ = ecs.Query[DeadTag](&world)
+		testutil.AssertEqual(t, len(es), 0)
+	})
+
+	t.Run("Collision", func(t *testing.T) {
+		// Placeholders never stand in for an entity of the world.
+		es, _ := ecs.Query[Position](&world)
+		target := es[len(es)-1]
+		for range target.ID() + 1 {
+			cb.NewEntity(&world)
+		}
+		ecs.AddDeferred(cb, target, DeadTag{})
+		cb.Flush(&world)
+		testutil.AssertEqual(t, world.Store(DeadTagID).Has(target), true)
+		testutil.AssertEqual(t, world.Store(DeadTagID).Len(), 1)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		count := world.EntityCount()
+		e := cb.NewEntity(&world)
+		cb.DestroyEntity(e)
+		cb.Reset()
+		testutil.AssertEqual(t, cb.Len(), 0)
+
+		// The ID of the placeholder is handed back, and the placeholder is
+		// stale.
+		ecs.AddDeferred(cb, e, Health{3})
+		cb.Flush(&world)
+		testutil.AssertEqual(t, world.IsAlive(e), false)
+		testutil.AssertEqual(t, world.EntityCount(), count)
+		testutil.AssertEqual(t, world.Store(HealthID).Has(e), false)
+	})
+}
@@ -1,35 +1,150 @@
 package ecs
 
-type ComponentID uint8
+import "fmt"
+
+type ComponentID uint16
 
 // Component represents any pure data type and is given an ID.
+//
+// Implementing Component is optional. Types without an ID method, such as
+// those from other packages, are given an ID by Register instead.
 type Component interface {
 	ID() ComponentID
 }
 
 // Initialize initializes a component which ensures that a store is created.
+// The store is kept at the ID given by the component, so two types sharing an
+// ID are refused.
 //
 // Initialize must be called before entities are added.
 func Initialize[T Component](w *World) bool {
+	return InitializeE[T](w) == nil
+}
+
+// InitializeE is Initialize returning ErrAlreadyInitialized, ErrDuplicateID or
+// ErrComponentLimit upon failure.
+func InitializeE[T Component](w *World) error {
 	var noop T
-	if w.components[noop.ID()] != nil || w.ComponentCount == cap(w.components) {
-		return false
+	return initialize[T](w, noop.ID())
+}
+
+// Register initializes a component and gives it the next free ID of the world,
+// which is returned. IDs are handed out from the top of the component limit
+// downward to stay clear of enumerated IDs, so the component table grows to the
+// limit. Registering a type again returns the ID it was given.
+//
+// Types implementing Component keep the ID of their method, so Register may be
+// used in place of Initialize.
+//
+// IDs depend on the order of registration. Worlds which share snapshots must
+// register their components in the same order.
+func Register[T any](w *World) (ComponentID, bool) {
+	id, err := RegisterE[T](w)
+	return id, err == nil
+}
+
+// RegisterE is Register returning ErrDuplicateID or ErrComponentLimit upon
+// failure.
+func RegisterE[T any](w *World) (ComponentID, error) {
+	if id, ok := ComponentIDOf[T](w); ok {
+		return id, nil
+	}
+	if c, ok := any(new(T)).(Component); ok {
+		return c.ID(), initialize[T](w, c.ID())
+	}
+	for id := int(w.componentLimit) - 1; id >= 0; id-- {
+		if id >= len(w.components) || w.components[id] == nil {
+			return ComponentID(id), initialize[T](w, ComponentID(id))
+		}
+	}
+	return 0, ErrComponentLimit
+}
+
+// ComponentIDOf returns the ID of an initialized component type.
+func ComponentIDOf[T any](w *World) (ComponentID, bool) {
+	return w.types.get(typeWord[T]())
+}
+
+// initialize creates the store of a component at the given ID. The component
+// table grows to fit the highest ID in use.
+func initialize[T any](w *World, id ComponentID) error {
+	key := typeWord[T]()
+	if _, ok := w.types.get(key); ok {
+		return ErrAlreadyInitialized
 	}
-	w.components[noop.ID()] = newComponentStore[T]()
+	if id >= w.componentLimit {
+		return fmt.Errorf("%w: component %d", ErrComponentLimit, id)
+	}
+	if n := int(id) + 1 - len(w.components); n > 0 {
+		w.components = append(w.components, make([]Store, n)...)
+	}
+	if w.components[id] != nil {
+		return fmt.Errorf("%w: component %d", ErrDuplicateID, id)
+	}
+	store := newComponentStore[T]()
+	store.now = w.tick
+	if w.concurrent {
+		store.lock = &rwLock{id: id}
+	}
+	store.em = w.entities
+	w.components[id] = store
+	w.initialized = append(w.initialized, id)
+	w.types.put(key, id)
 	w.ComponentCount++
-	return true
+	return nil
+}
+
+// storeOf returns the store of an initialized component type. The ID is
+// resolved once by initialize and cached by type, so no method of T is called.
+func storeOf[T any](w *World) (*componentStore[T], bool) {
+	id, ok := w.types.get(typeWord[T]())
+	if !ok || int(id) >= len(w.components) {
+		return nil, false
+	}
+	store, ok := w.components[id].(*componentStore[T])
+	return store, ok
 }
 
 // Add adds a component to an entity if that component was initialized.
-func Add[T Component](w *World, e Entity, c T) bool {
-	store, ok := w.components[c.ID()].(*componentStore[T])
+// Dead or stale entities are refused.
+func Add[T any](w *World, e Entity, c T) bool {
+	return AddE(w, e, c) == nil
+}
+
+// AddE is Add returning ErrNotInitialized, ErrStaleEntity or
+// ErrDuplicateComponent upon failure.
+func AddE[T any](w *World, e Entity, c T) error {
+	store, ok := storeOf[T](w)
 	if !ok {
-		return false
+		return ErrNotInitialized
 	}
-	return store.Add(e, c)
+	if w.concurrent {
+		return addHeld(w, store, e, c)
+	}
+	if !w.entities.isAlive(e) {
+		return ErrStaleEntity
+	}
+	if !store.add(e, c) {
+		return ErrDuplicateComponent
+	}
+	return nil
+}
+
+// addHeld is AddE for a concurrent world. The entity cannot be destroyed until the component is added.
+func addHeld[T any](w *World, store *componentStore[T], e Entity, c T) error {
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		return ErrStaleEntity
+	}
+	if !store.Add(e, c) {
+		return ErrDuplicateComponent
+	}
+	return nil
 }
 
-// Remove removes a component from an entity.
+// Remove removes a component from an entity. Dead or stale entities are
+// refused.
 //
 // Remove opts out of cleaning unused page memory for peformance.
 // To clean paged memory use RemoveAndClean.
@@ -37,16 +152,44 @@ func Add[T Component](w *World, e Entity, c T) bool {
 // Note that removal does not preserve order in the packed arrays.
 //
 // Time Complexity: O(1)
-func Remove[T Component](w *World, e Entity) bool {
-	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
+func Remove[T any](w *World, e Entity) bool {
+	return RemoveE[T](w, e) == nil
+}
+
+// RemoveE is Remove returning ErrNotInitialized, ErrStaleEntity or
+// ErrMissingComponent upon failure.
+func RemoveE[T any](w *World, e Entity) error {
+	store, ok := storeOf[T](w)
 	if !ok {
-		return ok
+		return ErrNotInitialized
+	}
+	if w.concurrent {
+		return removeHeld(w, store, e)
+	}
+	if !w.entities.isAlive(e) {
+		return ErrStaleEntity
+	}
+	if !store.remove(e) {
+		return ErrMissingComponent
+	}
+	return nil
+}
+
+// removeHeld is RemoveE for a concurrent world.
+func removeHeld[T any](w *World, store *componentStore[T], e Entity) error {
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		return ErrStaleEntity
+	}
+	if !store.Remove(e) {
+		return ErrMissingComponent
 	}
-	return store.Remove(e)
+	return nil
 }
 
 // RemoveAndClean removes a component from an entity and sweeps the page.
+// Dead or stale entities are refused.
 //
 // Additionally, it performs a reallocation of dense arrays which may cause
 // GC pauses if overused.
@@ -56,13 +199,40 @@ func Remove[T Component](w *World, e Entity) bool {
 // critical tasks use Remove.
 //
 // Time Complexity: O(N) where N is the page size.
-func RemoveAndClean[T Component](w *World, e Entity) bool {
-	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
+func RemoveAndClean[T any](w *World, e Entity) bool {
+	return RemoveAndCleanE[T](w, e) == nil
+}
+
+// RemoveAndCleanE is RemoveAndClean returning ErrNotInitialized,
+// ErrStaleEntity or ErrMissingComponent upon failure.
+func RemoveAndCleanE[T any](w *World, e Entity) error {
+	store, ok := storeOf[T](w)
 	if !ok {
-		return ok
+		return ErrNotInitialized
+	}
+	if w.concurrent {
+		return removeAndCleanHeld(w, store, e)
 	}
-	return store.RemoveAndClean(e)
+	if !w.entities.isAlive(e) {
+		return ErrStaleEntity
+	}
+	if !store.removeAndClean(e) {
+		return ErrMissingComponent
+	}
+	return nil
+}
+
+// removeAndCleanHeld is RemoveAndCleanE for a concurrent world.
+func removeAndCleanHeld[T any](w *World, store *componentStore[T], e Entity) error {
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		return ErrStaleEntity
+	}
+	if !store.RemoveAndClean(e) {
+		return ErrMissingComponent
+	}
+	return nil
 }
 
 // Sweep iterates through the component store freeing memory of empty pages.
@@ -71,21 +241,71 @@ func RemoveAndClean[T Component](w *World, e Entity) bool {
 // and space is a premium.
 //
 // Time Complexity: O(MN) where M is the page count and N is the page size.
-func Sweep[T Component](w *World) {
-	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
+func Sweep[T any](w *World) {
+	store, ok := storeOf[T](w)
 	if !ok {
 		return
 	}
-	store.entityIndices.Sweep()
+	store.Sweep()
 }
 
 // MemUsage reports the memory being used by the component store in bytes.
-func MemUsage[T Component](w *World) uintptr {
-	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
+func MemUsage[T any](w *World) uintptr {
+	store, ok := storeOf[T](w)
 	if !ok {
 		return 0
 	}
 	return store.MemUsage()
 }
+
+// typeTable maps the type of each initialized component to its ID. It is an
+// open addressing hash table keyed by typeWord, so a lookup is a shift and a
+// compare rather than the hash of an interface. It is only written by
+// initialize, and is always less than half full.
+type typeTable struct {
+	slots []typeSlot
+	count int
+}
+
+// typeSlot is an entry of a typeTable. A zero key marks an empty slot.
+type typeSlot struct {
+	key uintptr
+	id  ComponentID
+}
+
+// get returns the ID of the type with the given key.
+func (t *typeTable) get(key uintptr) (ComponentID, bool) {
+	if len(t.slots) == 0 {
+		return 0, false
+	}
+	mask := uintptr(len(t.slots) - 1)
+	for i := key >> 4 & mask; ; i = (i + 1) & mask {
+		switch t.slots[i].key {
+		case key:
+			return t.slots[i].id, true
+		case 0:
+			return 0, false
+		}
+	}
+}
+
+// put adds the type with the given key, which must not be in the table.
+func (t *typeTable) put(key uintptr, id ComponentID) {
+	if 2*(t.count+1) > len(t.slots) {
+		slots := t.slots
+		t.slots = make([]typeSlot, max(16, 2*len(slots)))
+		t.count = 0
+		for _, s := range slots {
+			if s.key != 0 {
+				t.put(s.key, s.id)
+			}
+		}
+	}
+	mask := uintptr(len(t.slots) - 1)
+	i := key >> 4 & mask
+	for t.slots[i].key != 0 {
+		i = (i + 1) & mask
+	}
+	t.slots[i] = typeSlot{key: key, id: id}
+	t.count++
+}
@@ -1,11 +1,44 @@
 package ecs
 
 import (
+	"slices"
 	"unsafe"
 
+	"github.com/jdavasligil/go-ecs/pkg/bitset"
 	"github.com/jdavasligil/go-ecs/pkg/pagearray"
 )
 
+// Store is the type-erased view of a component store. It allows operating
+// across every initialized store without knowing the component type.
+type Store interface {
+	// Has reports whether the entity is registered with the store.
+	Has(e Entity) bool
+
+	// Remove unregisters the entity from the store.
+	Remove(e Entity) bool
+
+	// Copy registers a copy of the component of src to dst.
+	Copy(src, dst Entity) bool
+
+	// Component returns a pointer to the component of the entity, or nil.
+	Component(e Entity) any
+
+	// Len returns the number of entities registered with the store.
+	Len() int
+
+	// Entities returns the packed array of registered entities.
+	Entities() []Entity
+
+	// MemUsage returns an estimate for the current memory being used in bytes.
+	MemUsage() uintptr
+
+	// Reset throws away all entities and allocated memory.
+	Reset()
+
+	// Sweep frees the memory of empty pages.
+	Sweep()
+}
+
 // componentStore is a sparse set used for each registered Component type which
 // maps entities to their components.
 //
@@ -14,10 +47,8 @@ import (
 //	Add    - O(1)
 //	Get    - O(1)
 //	Remove - O(1)
-type componentStore[T Component] struct {
-	// entityIndices is a sparse array that holds the indices into EntityList.
-	// The array is indexed by the entity id itself. A value of -1 means empty.
-	entityIndices pagearray.PageArray
+type componentStore[T any] struct {
+	membership
 
 	// entityList is a packed array that contains the entities. The index
 	// corresponds to the value from entityIndices.
@@ -27,51 +58,210 @@ type componentStore[T Component] struct {
 	// is aligned with entityList (i.e., entityList[i] corresponds to data in
 	// componentList[i]).
 	componentList []T
+
+	// ticks is a packed array that records when each component was added and
+	// last changed. The array is aligned with entityList.
+	ticks []componentTicks
+
+	// removals is a log of entities whose component was removed.
+	removals []removal
+
+	// removalIndices is a sparse array that holds the indices into removals.
+	// The array is indexed by the entity id itself. A value of -1 means empty.
+	removalIndices pagearray.PageArray
+
+	// now points to the current tick of the World which owns the store.
+	now *Tick
+
+	// codec encodes and decodes the component data for snapshots, if any.
+	codec Codec[T]
+
+	// group is the group which owns the store, if any. Owned stores keep the
+	// members of the group packed at the front of each array.
+	group *group
+
+	// observers are notified of changes to the store in the order observed.
+	observers []Observer
+
+	// world is passed to observers. It is set when the first one is observed.
+	world *World
+
+	// lock guards the store in a concurrent world, otherwise it is nil.
+	lock *rwLock
+
+	// disabled marks the entities whose component was disabled on its own,
+	// rather than with the whole entity. The bitset is indexed by the entity id
+	// itself.
+	disabled bitset.BitsetUint64
+
+	// em tells whether an entity is disabled as a whole. It is nil for
+	// internal stores.
+	em *entityManager
+}
+
+// membership finds the entities of a component store. It does not depend on
+// the component type, so its lookups stay cheap enough to inline into the
+// generic queries.
+type membership struct {
+	// entityIndices is a sparse array that holds the indices into EntityList.
+	// The array is indexed by the entity id itself. A value of -1 means empty.
+	entityIndices pagearray.PageArray
+
+	// tagBits holds the enabled entities of a store with TagStorage, indexed by
+	// the entity id itself. Only one of tagBits and entityIndices is ever
+	// filled.
+	tagBits bitset.BitsetUint64
+
+	// tags holds the disabled entities of a store with TagStorage, otherwise it
+	// is nil. Tag stores keep neither sparse indices nor ticks.
+	tags *tagSet
+
+	// enabled is the number of components which are not disabled. They are
+	// kept at the front of the packed arrays, so queries and iterators only
+	// look at the first enabled elements.
+	enabled int
+}
+
+// componentTicks records when a component was added and last changed.
+type componentTicks struct {
+	added   Tick
+	changed Tick
+}
+
+// removal records when a component was removed from an entity.
+type removal struct {
+	entity Entity
+	tick   Tick
 }
 
 // NewcomponentStore constructs a component store for a particular component type.
-func newComponentStore[T Component]() *componentStore[T] {
+func newComponentStore[T any]() *componentStore[T] {
 	p := &componentStore[T]{
-		entityIndices: pagearray.NewPageArray(),
-		entityList:    make([]Entity, 0),
-		componentList: make([]T, 0),
+		membership:     membership{entityIndices: pagearray.NewPageArray()},
+		entityList:     make([]Entity, 0),
+		componentList:  make([]T, 0),
+		ticks:          make([]componentTicks, 0),
+		removals:       make([]removal, 0),
+		removalIndices: pagearray.NewPageArray(),
+		now:            new(Tick),
 	}
 	return p
 }
 
-func (p *componentStore[T]) IsRegistered(e Entity) bool {
-	return p.entityIndices.At(int(e.ID())) >= 0
+// Has reports whether the entity is registered with the store.
+func (p *componentStore[T]) Has(e Entity) bool {
+	p.lock.RLock()
+	idx := p.find(e)
+	p.lock.RUnlock()
+	return idx >= 0
 }
 
 // Add registers component of type T to the entity. Returns true if successful.
+// The entities must be held in a concurrent world. See entityManager.Hold.
 func (p *componentStore[T]) Add(e Entity, c T) bool {
-	if p.IsRegistered(e) {
+	if p.lock != nil {
+		return p.addLocked(e, c)
+	}
+	return p.add(e, c)
+}
+
+func (p *componentStore[T]) addLocked(e Entity, c T) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.add(e, c)
+}
+
+func (p *componentStore[T]) add(e Entity, c T) bool {
+	if p.taken(e) {
 		return false
 	}
-	p.entityIndices.Set(int(e.ID()), len(p.entityList))
-	p.entityList = append(p.entityList, e)
-	p.componentList = append(p.componentList, c)
+	if p.tags != nil {
+		p.tag(e, c)
+	} else {
+		p.entityIndices.Set(int(e.ID()), len(p.entityList))
+		p.entityList = append(p.entityList, e)
+		p.componentList = append(p.componentList, c)
+		p.ticks = append(p.ticks, componentTicks{added: *p.now, changed: *p.now})
+	}
+	// The component starts out disabled, and stays so if the entity is.
+	if p.em == nil || p.em.isEnabled(e) {
+		p.show(e)
+	}
+	for _, o := range p.observers {
+		if o.OnAdd != nil {
+			o.OnAdd(p.world, e)
+		}
+	}
 	return true
 }
 
+// Copy registers a shallow copy of the component of src to dst. Returns true
+// if successful. Dead or stale destinations are refused.
+func (p *componentStore[T]) Copy(src, dst Entity) bool {
+	alive := p.em.Hold(dst)
+	defer p.em.Release()
+	if !alive {
+		return false
+	}
+	c, ok := p.GetComponent(src)
+	if !ok {
+		return false
+	}
+	return p.Add(dst, c)
+}
+
 // RemoveAndClean unregisters the entity from the component.
 // Memory is reallocated causing a GC dump. Use sparingly.
 func (p *componentStore[T]) RemoveAndClean(e Entity) bool {
-	if !p.IsRegistered(e) {
+	if p.lock != nil {
+		return p.removeAndCleanLocked(e)
+	}
+	return p.removeAndClean(e)
+}
+
+func (p *componentStore[T]) removeAndCleanLocked(e Entity) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.removeAndClean(e)
+}
+
+func (p *componentStore[T]) removeAndClean(e Entity) bool {
+	if p.find(e) < 0 {
 		return false
 	}
+	// The group moves the entity out first, so observers are told of the move
+	// before the removal.
+	if p.group != nil {
+		p.group.removed(e)
+	}
+	for _, o := range p.observers {
+		if o.OnRemove != nil {
+			o.OnRemove(p.world, e)
+		}
+	}
+	p.logRemoval(e)
+	clearBit(p.disabled, int(e.ID()))
+	if p.tags != nil {
+		p.untag(e)
+		return true
+	}
 	// Get index of the entity to be removed.
 	idx := p.entityIndices.At(int(e.ID()))
-	// Swap the last entity/component with the one marked for removal.
-	p.entityList[idx] = p.entityList[len(p.entityList)-1]
-	p.componentList[idx] = p.componentList[len(p.componentList)-1]
-	// Update the new index location for the swapped data.
-	p.entityIndices.Set(int(p.entityList[idx].ID()), idx)
+	// Move the last enabled entity/component into the gap, so the enabled
+	// ones stay in front, and leave its own place to be filled instead.
+	if idx < p.enabled {
+		p.enabled--
+		p.fill(idx, p.enabled)
+		idx = p.enabled
+	}
+	// Move the last entity/component into the gap.
+	p.fill(idx, len(p.entityList)-1)
 	// Unregister the removed entity.
 	p.entityIndices.SweepAndClear(int(e.ID()))
 	// Delete the last entity/component.
 	p.entityList = append([]Entity(nil), p.entityList[:len(p.entityList)-1]...)
 	p.componentList = append([]T(nil), p.componentList[:len(p.componentList)-1]...)
+	p.ticks = append([]componentTicks(nil), p.ticks[:len(p.ticks)-1]...)
 
 	return true
 }
@@ -79,65 +269,390 @@ func (p *componentStore[T]) RemoveAndClean(e Entity) bool {
 // Remove unregisters the entity from the component store.
 // Memory is not reallocated. This is good if you want to reuse the memory.
 func (p *componentStore[T]) Remove(e Entity) bool {
-	if !p.IsRegistered(e) {
+	if p.lock != nil {
+		return p.removeLocked(e)
+	}
+	return p.remove(e)
+}
+
+func (p *componentStore[T]) removeLocked(e Entity) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.remove(e)
+}
+
+func (p *componentStore[T]) remove(e Entity) bool {
+	if p.find(e) < 0 {
 		return false
 	}
+	// The group moves the entity out first, so observers are told of the move
+	// before the removal.
+	if p.group != nil {
+		p.group.removed(e)
+	}
+	for _, o := range p.observers {
+		if o.OnRemove != nil {
+			o.OnRemove(p.world, e)
+		}
+	}
+	p.logRemoval(e)
+	clearBit(p.disabled, int(e.ID()))
+	if p.tags != nil {
+		p.untag(e)
+		return true
+	}
 	// Get index of the entity to be removed.
 	idx := p.entityIndices.At(int(e.ID()))
-	// Swap the last entity/component with the one marked for removal.
-	p.entityList[idx] = p.entityList[len(p.entityList)-1]
-	p.componentList[idx] = p.componentList[len(p.componentList)-1]
-	// Update the new index location for the swapped data.
-	p.entityIndices.Set(int(p.entityList[idx].ID()), idx)
+	// Move the last enabled entity/component into the gap, so the enabled
+	// ones stay in front, and leave its own place to be filled instead.
+	if idx < p.enabled {
+		p.enabled--
+		p.fill(idx, p.enabled)
+		idx = p.enabled
+	}
+	// Move the last entity/component into the gap.
+	p.fill(idx, len(p.entityList)-1)
 	// Unregister the removed entity.
 	p.entityIndices.Clear(int(e.ID()))
 	// Delete the last entity/component.
 	p.entityList = p.entityList[:len(p.entityList)-1]
 	p.componentList = p.componentList[:len(p.componentList)-1]
+	p.ticks = p.ticks[:len(p.ticks)-1]
 
 	return true
 }
 
 // Retrieves the component data associated with a specific entity.
 func (p *componentStore[T]) GetComponent(e Entity) (T, bool) {
-	if !p.IsRegistered(e) {
+	if p.lock != nil {
+		return p.getComponentLocked(e)
+	}
+	return p.getComponent(e)
+}
+
+func (p *componentStore[T]) getComponentLocked(e Entity) (T, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.getComponent(e)
+}
+
+func (p *componentStore[T]) getComponent(e Entity) (T, bool) {
+	idx := p.find(e)
+	if idx < 0 {
 		var noop T
 		return noop, false
 	}
-	return p.componentList[p.entityIndices.At(int(e.ID()))], true
+	return p.componentList[idx], true
 }
 
 // Retrieves a mutable reference to the  component data associated with a
 // specific entity. Only a single caller may claim ownership at a time.
+//
+// The component is marked as changed.
 func (p *componentStore[T]) GetMutComponent(e Entity) (*T, bool) {
-	if !p.IsRegistered(e) {
-		return nil, false
+	var c *T
+	p.lock.Lock()
+	idx := p.find(e)
+	if idx >= 0 {
+		if p.tags == nil {
+			p.ticks[idx].changed = *p.now
+		}
+		c = &p.componentList[idx]
+	}
+	p.lock.Unlock()
+	return c, idx >= 0
+}
+
+// Set replaces the component data of the entity and marks it as changed.
+func (p *componentStore[T]) Set(e Entity, c T) bool {
+	if p.lock != nil {
+		return p.setLocked(e, c)
+	}
+	return p.set(e, c)
+}
+
+func (p *componentStore[T]) setLocked(e Entity, c T) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.set(e, c)
+}
+
+func (p *componentStore[T]) set(e Entity, c T) bool {
+	idx := p.find(e)
+	if idx < 0 {
+		return false
+	}
+	p.componentList[idx] = c
+	p.markChanged(e, idx)
+	return true
+}
+
+// MarkChanged records that the component of the entity was changed. Tag
+// stores record no ticks, so they are refused.
+func (p *componentStore[T]) MarkChanged(e Entity) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	idx := p.find(e)
+	if idx < 0 || p.tags != nil {
+		return false
+	}
+	p.markChanged(e, idx)
+	return true
+}
+
+// markChanged records the change of the component at index idx and notifies
+// observers.
+func (p *componentStore[T]) markChanged(e Entity, idx int) {
+	if p.tags == nil {
+		p.ticks[idx].changed = *p.now
+	}
+	for _, o := range p.observers {
+		if o.OnSet != nil {
+			o.OnSet(p.world, e)
+		}
+	}
+}
+
+// AddedSince reports whether the component was added to the entity after the
+// given tick.
+func (p *componentStore[T]) AddedSince(e Entity, since Tick) bool {
+	p.lock.RLock()
+	idx := p.entityIndices.At(int(e.ID()))
+	added := idx >= 0 && p.ticks[idx].added > since
+	p.lock.RUnlock()
+	return added
+}
+
+// ChangedSince reports whether the component of the entity was added or
+// changed after the given tick.
+func (p *componentStore[T]) ChangedSince(e Entity, since Tick) bool {
+	p.lock.RLock()
+	idx := p.entityIndices.At(int(e.ID()))
+	changed := idx >= 0 && p.ticks[idx].changed > since
+	p.lock.RUnlock()
+	return changed
+}
+
+// RemovedSince reports whether the component was removed from the entity after
+// the given tick.
+func (p *componentStore[T]) RemovedSince(e Entity, since Tick) bool {
+	p.lock.RLock()
+	idx := p.removalIndices.At(int(e.ID()))
+	removed := idx >= 0 && p.removals[idx].entity == e && p.removals[idx].tick > since
+	p.lock.RUnlock()
+	return removed
+}
+
+// Removals returns the entities whose component was removed after the given
+// tick.
+func (p *componentStore[T]) Removals(since Tick) []Entity {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	es := make([]Entity, 0)
+	for _, r := range p.removals {
+		if r.tick > since {
+			es = append(es, r.entity)
+		}
 	}
-	return &p.componentList[p.entityIndices.At(int(e.ID()))], true
+	return es
 }
 
+// ClearRemovals throws away the log of removed components.
+func (p *componentStore[T]) ClearRemovals() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.removals = p.removals[:0]
+	p.removalIndices.Reset()
+}
+
+// logRemoval records the removal of the component from the entity. An entity
+// appears in the log at most once with its latest removal.
+func (p *componentStore[T]) logRemoval(e Entity) {
+	idx := p.removalIndices.At(int(e.ID()))
+	if idx >= 0 {
+		p.removals[idx] = removal{entity: e, tick: *p.now}
+		return
+	}
+	p.removalIndices.Set(int(e.ID()), len(p.removals))
+	p.removals = append(p.removals, removal{entity: e, tick: *p.now})
+}
+
+// Entities returns the packed array of registered entities. In a concurrent
+// world the array is copied.
 func (p *componentStore[T]) Entities() []Entity {
+	if p.lock != nil {
+		p.lock.RLock()
+		defer p.lock.RUnlock()
+		return slices.Clone(p.entityList)
+	}
+	return p.entityList
+}
+
+func (p *componentStore[T]) entities() []Entity {
 	return p.entityList
 }
 
+// Component returns a pointer to the component of the entity as any. Nil is
+// returned if the entity is not registered.
+//
+// Writes through the pointer are not tracked. See MarkChanged.
+func (p *componentStore[T]) Component(e Entity) any {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.component(e)
+}
+
+func (p *componentStore[T]) component(e Entity) any {
+	idx := p.find(e)
+	if idx < 0 {
+		return nil
+	}
+	return &p.componentList[idx]
+}
+
+// Components returns the packed array of component data. In a concurrent
+// world the array is copied.
 func (p *componentStore[T]) Components() []T {
+	if p.lock != nil {
+		p.lock.RLock()
+		defer p.lock.RUnlock()
+		return slices.Clone(p.componentList)
+	}
 	return p.componentList
 }
 
-func (p *componentStore[T]) Size() int {
-	return len(p.entityList)
+func (p *componentStore[T]) Len() int {
+	p.lock.RLock()
+	n := len(p.entityList)
+	p.lock.RUnlock()
+	return n
 }
 
 // Reset performs a hard reset by throwing away all allocated memory for
 // garbage collection. May negatively affect garbage collection performance.
 func (p *componentStore[T]) Reset() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.group != nil {
+		p.group.size = 0
+	}
 	p.entityIndices.Reset()
 	p.entityList = make([]Entity, 0, 256)
 	p.componentList = make([]T, 0, 256)
+	p.ticks = make([]componentTicks, 0, 256)
+	p.removals = make([]removal, 0)
+	p.removalIndices.Reset()
+	p.disabled = nil
+	p.enabled = 0
+	if p.tags != nil {
+		p.tagBits = nil
+		p.tags = newTagSet()
+	}
+}
+
+// index returns the position of the entity in the packed arrays, or -1. Every
+// tag shares the first position, as the components are zero-size.
+//
+// The bitset is empty unless the store has TagStorage, and the sparse array is
+// empty otherwise, so neither needs telling apart. Keep it cheap to inline.
+func (m *membership) index(e Entity) int {
+	id := int(e.ID())
+	if id>>6 < len(m.tagBits) {
+		return int(m.tagBits[id>>6]>>(id&63)&1) - 1
+	}
+	return m.entityIndices.At(id)
+}
+
+// isEnabled reports whether the position given by index is that of an enabled
+// component. It is false for -1.
+func (m *membership) isEnabled(idx int) bool {
+	return uint(idx) < uint(m.enabled)
+}
+
+// find returns the position of the entity in the packed arrays, or -1. Unlike
+// index it also finds the disabled components of a tag store, and it refuses a
+// stale entity whose ID was recycled to another.
+func (p *componentStore[T]) find(e Entity) int {
+	if p.tags == nil {
+		idx := p.index(e)
+		if idx < 0 || p.entityList[idx] != e {
+			return -1
+		}
+		return idx
+	}
+	es := p.entityList[p.enabled:]
+	switch id := int(e.ID()); {
+	case hasBit(p.tagBits, id):
+		es = p.entityList[:p.enabled]
+	case !hasBit(p.tags.inactive, id):
+		return -1
+	}
+	if es[position(es, e)] != e {
+		return -1
+	}
+	return 0
+}
+
+// taken reports whether the ID of the entity has a component, whatever its
+// version.
+func (p *componentStore[T]) taken(e Entity) bool {
+	return p.index(e) >= 0 || p.tags != nil && hasBit(p.tags.inactive, int(e.ID()))
+}
+
+// fill moves the element at src over the element at dst in the packed arrays.
+func (p *componentStore[T]) fill(dst, src int) {
+	if dst == src {
+		return
+	}
+	p.entityList[dst] = p.entityList[src]
+	p.componentList[dst] = p.componentList[src]
+	p.ticks[dst] = p.ticks[src]
+	// Update the new index location for the moved data.
+	p.entityIndices.Set(int(p.entityList[dst].ID()), dst)
+	p.moved(dst)
+}
+
+func (p *componentStore[T]) swap(i, j int) {
+	if i == j {
+		return
+	}
+	p.entityList[i], p.entityList[j] = p.entityList[j], p.entityList[i]
+	p.componentList[i], p.componentList[j] = p.componentList[j], p.componentList[i]
+	p.ticks[i], p.ticks[j] = p.ticks[j], p.ticks[i]
+	p.entityIndices.Set(int(p.entityList[i].ID()), i)
+	p.entityIndices.Set(int(p.entityList[j].ID()), j)
+	p.moved(i)
+	p.moved(j)
+}
+
+// moved notifies observers that the component at index i was moved there.
+func (p *componentStore[T]) moved(i int) {
+	for _, o := range p.observers {
+		if o.OnMove != nil {
+			o.OnMove(p.world, p.entityList[i], i)
+		}
+	}
+}
+
+func (p *componentStore[T]) owner() *group {
+	return p.group
+}
+
+func (p *componentStore[T]) setOwner(g *group) {
+	p.group = g
+}
+
+// Sweep iterates through the sparse array freeing memory of empty pages.
+func (p *componentStore[T]) Sweep() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.entityIndices.Sweep()
 }
 
 // MemUsage returns an estimate for the current memory being used in bytes.
 func (p *componentStore[T]) MemUsage() uintptr {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	var entityType Entity
 	var componentType T
 	size := unsafe.Sizeof(*p)
@@ -146,5 +661,13 @@ func (p *componentStore[T]) MemUsage() uintptr {
 	size += unsafe.Sizeof(entityType) * uintptr(cap(p.entityList))
 	size += unsafe.Sizeof(componentType) * uintptr(cap(p.componentList))
 	size += p.entityIndices.MemUsage()
+	size += unsafe.Sizeof(componentTicks{}) * uintptr(cap(p.ticks))
+	size += unsafe.Sizeof(removal{}) * uintptr(cap(p.removals))
+	size += p.removalIndices.MemUsage()
+	size += uintptr(cap(p.disabled)) * unsafe.Sizeof(uint64(0))
+	if p.tags != nil {
+		size += unsafe.Sizeof(*p.tags)
+		size += uintptr(cap(p.tagBits)+cap(p.tags.inactive)) * unsafe.Sizeof(uint64(0))
+	}
 	return size
 }
@@ -21,15 +21,15 @@ func testComponentStore(t *testing.T) {
 	entity2 := newEntity(2)
 	t.Run("AddRemove", func(t *testing.T) {
 		store.Reset()
-		testutil.AssertEqual(t, store.Size(), 0)
+		testutil.AssertEqual(t, store.Len(), 0)
 		testutil.AssertEqual(t, store.Add(entity1, myComponent{"A", 1, 2}), true)
 		testutil.AssertEqual(t, store.Add(entity1, myComponent{"B", 2, 3}), false)
-		testutil.AssertEqual(t, store.Size(), 1)
+		testutil.AssertEqual(t, store.Len(), 1)
 		testutil.AssertEqual(t, store.Add(entity2, myComponent{"B", 2, 3}), true)
-		testutil.AssertEqual(t, store.Size(), 2)
+		testutil.AssertEqual(t, store.Len(), 2)
 		testutil.AssertEqual(t, store.Remove(entity1), true)
 		testutil.AssertEqual(t, store.Remove(entity2), true)
-		testutil.AssertEqual(t, store.Size(), 0)
+		testutil.AssertEqual(t, store.Len(), 0)
 	})
 	t.Run("GetComponent", func(t *testing.T) {
 		store.Reset()
@@ -0,0 +1,86 @@
+package ecs
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// rwLock guards a component store or the entities of a concurrent world. It is
+// nil in a world which is not concurrent, and every method of a nil lock does
+// nothing, so the single-threaded path only pays for a nil check.
+type rwLock struct {
+	rw sync.RWMutex
+
+	// id orders the locks of component stores. See rlock.
+	id ComponentID
+}
+
+func (l *rwLock) Lock() {
+	if l != nil {
+		l.lock()
+	}
+}
+
+func (l *rwLock) Unlock() {
+	if l != nil {
+		l.unlock()
+	}
+}
+
+func (l *rwLock) RLock() {
+	if l != nil {
+		l.rlock()
+	}
+}
+
+func (l *rwLock) RUnlock() {
+	if l != nil {
+		l.runlock()
+	}
+}
+
+// The mutex is only reached through these functions, which are not inlined,
+// so that the nil checks above are cheap enough to inline into the hot paths.
+
+//go:noinline
+func (l *rwLock) lock() { l.rw.Lock() }
+
+//go:noinline
+func (l *rwLock) unlock() { l.rw.Unlock() }
+
+//go:noinline
+func (l *rwLock) rlock() { l.rw.RLock() }
+
+//go:noinline
+func (l *rwLock) runlock() { l.rw.RUnlock() }
+
+// rlock read locks several component stores and returns the function which
+// unlocks them. Nil locks and repeated locks are skipped.
+//
+// Writers hold a single store lock at a time, while readers of several stores
+// always lock them in order of ComponentID. Hence a reader waiting on a writer
+// never holds a lock which that writer is waiting on. The lock of the entities
+// is always taken before any store lock. See entityManager.Hold.
+func rlock(locks ...*rwLock) func() {
+	locks = slices.DeleteFunc(locks, func(l *rwLock) bool { return l == nil })
+	slices.SortFunc(locks, func(a, b *rwLock) int { return cmp.Compare(a.id, b.id) })
+	locks = slices.Compact(locks)
+	for _, l := range locks {
+		l.RLock()
+	}
+	return func() {
+		for _, l := range locks {
+			l.RUnlock()
+		}
+	}
+}
+
+// storeLock returns the lock of the store, which is nil if the store is nil or
+// the world is not concurrent.
+func (p *componentStore[T]) storeLock() *rwLock {
+	if p == nil {
+		return nil
+	}
+	return p.lock
+}
@@ -0,0 +1,152 @@
+package ecs_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestConcurrent(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1 << 16,
+		RecycleLimit:   1 << 16,
+		ComponentLimit: 255,
+		Concurrent:     true,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[DeadTag](&world)
+	ecs.SetStorage[DeadTag](&world, ecs.TagStorage)
+
+	t.Run("Refused", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.Observe[Position](&world, ecs.Observer{}), false)
+		testutil.AssertEqual(t, ecs.InitializeHierarchy(&world, ecs.Orphan), false)
+		es, ps, hs := ecs.Group2[Position, Health](&world)
+		testutil.AssertEqual(t, es == nil && ps == nil && hs == nil, true)
+
+		e := world.NewEntity()
+		ecs.Add(&world, e, Position{})
+		_, ok := ecs.GetMut[Position](&world, e)
+		testutil.AssertEqual(t, ok, false)
+		world.DestroyEntity(e)
+	})
+
+	const writers = 4
+	const count = 1000
+
+	var wg sync.WaitGroup
+	created := make([][]ecs.Entity, writers)
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range count {
+				e := world.NewEntity()
+				ecs.Add(&world, e, Position{x: float32(i)})
+				ecs.Add(&world, e, Health{hp: i})
+				if i%2 == 0 {
+					ecs.Add(&world, e, DeadTag{})
+				}
+				if i%4 == 0 {
+					world.DestroyEntity(e)
+					continue
+				}
+				created[w] = append(created[w], e)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	readers.Add(1)
+	go func() {
+		defer readers.Done()
+		q := ecs.NewQueryBuilder().With(PositionID).Without(DeadTagID)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			for _, c := range ecs.Each2[Position, Health](&world) {
+				if c.A.x != float32(c.B.hp) {
+					t.Errorf("Each2 yielded mismatched components %v and %v", *c.A, *c.B)
+				}
+			}
+			for _, e := range ecs.QueryExclude[Position, DeadTag](&world) {
+				ecs.Get[Position](&world, e)
+			}
+			tagged, _ := ecs.Query[DeadTag](&world)
+			for _, e := range tagged {
+				ecs.Get[DeadTag](&world, e)
+			}
+			es, ps := ecs.Query[Position](&world)
+			if len(es) != len(ps) {
+				t.Errorf("Query returned %d entities and %d components", len(es), len(ps))
+			}
+			for range q.Each(&world) {
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+	readers.Wait()
+
+	seen := make(map[ecs.Entity]bool)
+	for _, es := range created {
+		for _, e := range es {
+			testutil.AssertEqual(t, seen[e], false)
+			seen[e] = true
+			testutil.AssertEqual(t, world.IsAlive(e), true)
+		}
+	}
+	testutil.AssertEqual(t, world.EntityCount(), writers*count*3/4)
+	testutil.AssertEqual(t, len(ecs.Query2[Position, Health](&world)), writers*count*3/4)
+	testutil.AssertEqual(t, len(ecs.QueryExclude[Position, DeadTag](&world)), writers*count/2)
+}
+
+func TestConcurrentDestroy(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1 << 16,
+		RecycleLimit:   1 << 16,
+		ComponentLimit: 255,
+		Concurrent:     true,
+	})
+	ecs.Initialize[Position](&world)
+
+	const count = 2000
+	entities := make([]ecs.Entity, count)
+	for i := range entities {
+		entities[i] = world.NewEntity()
+	}
+
+	// Components are added to the entities while they are being destroyed.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for _, e := range entities {
+			ecs.Add(&world, e, Position{})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for _, e := range entities {
+			world.DestroyEntity(e)
+		}
+	}()
+	wg.Wait()
+
+	es, _ := ecs.Query[Position](&world)
+	testutil.AssertEqual(t, len(es), 0)
+
+	// Recycled IDs do not inherit components of the destroyed entities.
+	for range count {
+		e := world.NewEntity()
+		_, ok := ecs.Get[Position](&world, e)
+		testutil.AssertEqual(t, ok, false)
+	}
+}
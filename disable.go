@@ -0,0 +1,242 @@
+package ecs
+
+import "slices"
+
+// Disable hides the component of an entity from queries and iterators without
+// removing it, so the data is kept and the other packed arrays are left as
+// they are. Dead or stale entities are refused.
+//
+// Disabled components can still be read and written with Get, GetMut and Set.
+// They are found by Disabled and by a QueryBuilder which includes them.
+//
+// Time Complexity: O(1)
+func Disable[T any](w *World, e Entity) bool {
+	return DisableE[T](w, e) == nil
+}
+
+// DisableE is Disable returning ErrNotInitialized, ErrStaleEntity or
+// ErrMissingComponent upon failure.
+func DisableE[T any](w *World, e Entity) error {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return ErrNotInitialized
+	}
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		return ErrStaleEntity
+	}
+	if !store.Disable(e) {
+		return ErrMissingComponent
+	}
+	return nil
+}
+
+// Enable shows a component which was disabled with Disable to queries and
+// iterators again. It stays hidden while the whole entity is disabled. Dead or
+// stale entities are refused.
+//
+// Time Complexity: O(1)
+func Enable[T any](w *World, e Entity) bool {
+	return EnableE[T](w, e) == nil
+}
+
+// EnableE is Enable returning ErrNotInitialized, ErrStaleEntity or
+// ErrMissingComponent upon failure.
+func EnableE[T any](w *World, e Entity) error {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return ErrNotInitialized
+	}
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		return ErrStaleEntity
+	}
+	if !store.Enable(e) {
+		return ErrMissingComponent
+	}
+	return nil
+}
+
+// IsEnabled reports whether the entity has component T and neither the
+// component nor the entity is disabled.
+func IsEnabled[T any](w *World, e Entity) bool {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return false
+	}
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	return alive && store.IsEnabled(e)
+}
+
+// Disabled returns slices to the entities and the components of type T which
+// are hidden from queries, whether the component or the whole entity was
+// disabled. See Query.
+//
+// Slices are possibly nil.
+func Disabled[T any](w *World) ([]Entity, []T) {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return nil, nil
+	}
+	if w.concurrent {
+		store.lock.RLock()
+		defer store.lock.RUnlock()
+		return slices.Clone(store.entityList[store.enabled:]), slices.Clone(store.componentList[store.enabled:])
+	}
+	return store.entityList[store.enabled:], store.componentList[store.enabled:]
+}
+
+// DisableEntity hides the entity from every query and iterator, as if each of
+// its components was disabled. Components added while the entity is disabled
+// are hidden too. Dead or stale entities are refused.
+//
+// Time Complexity: O(C) where C = # Initialized components
+func (w *World) DisableEntity(e Entity) bool {
+	w.entities.lock.Lock()
+	defer w.entities.lock.Unlock()
+	if !w.entities.setEnabled(e, false) {
+		return false
+	}
+	for _, id := range w.initialized {
+		if s, ok := w.components[id].(toggledStore); ok {
+			s.setEntityEnabled(e, false)
+		}
+	}
+	return true
+}
+
+// EnableEntity shows an entity which was disabled with DisableEntity to
+// queries and iterators again. Components which were disabled on their own
+// stay hidden. Dead or stale entities are refused.
+//
+// Time Complexity: O(C) where C = # Initialized components
+func (w *World) EnableEntity(e Entity) bool {
+	w.entities.lock.Lock()
+	defer w.entities.lock.Unlock()
+	if !w.entities.setEnabled(e, true) {
+		return false
+	}
+	for _, id := range w.initialized {
+		if s, ok := w.components[id].(toggledStore); ok {
+			s.setEntityEnabled(e, true)
+		}
+	}
+	return true
+}
+
+// IsEnabled reports whether the entity is living and not disabled.
+func (w *World) IsEnabled(e Entity) bool {
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	return alive && w.entities.isEnabled(e)
+}
+
+// toggledStore is implemented by component stores whose components can be
+// hidden when their entity is disabled.
+type toggledStore interface {
+	setEntityEnabled(e Entity, enabled bool)
+}
+
+// Disable hides the component of the entity from queries. Returns false if the
+// entity is not registered.
+func (p *componentStore[T]) Disable(e Entity) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.find(e) < 0 {
+		return false
+	}
+	setBit(&p.disabled, int(e.ID()))
+	p.hide(e)
+	return true
+}
+
+// Enable shows the component of the entity to queries, unless the entity is
+// disabled. Returns false if the entity is not registered. The entities must
+// be held in a concurrent world.
+func (p *componentStore[T]) Enable(e Entity) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.find(e) < 0 {
+		return false
+	}
+	clearBit(p.disabled, int(e.ID()))
+	if p.em == nil || p.em.isEnabled(e) {
+		p.show(e)
+	}
+	return true
+}
+
+// IsEnabled reports whether the entity is registered and visible to queries.
+func (p *componentStore[T]) IsEnabled(e Entity) bool {
+	p.lock.RLock()
+	enabled := p.isEnabled(p.index(e))
+	p.lock.RUnlock()
+	return enabled
+}
+
+func (p *componentStore[T]) setEntityEnabled(e Entity, enabled bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	switch {
+	case p.find(e) < 0:
+	case !enabled:
+		p.hide(e)
+	case !hasBit(p.disabled, int(e.ID())):
+		p.show(e)
+	}
+}
+
+// enabledEntities returns the front of the packed array of entities, which
+// queries look at.
+func (p *componentStore[T]) enabledEntities() []Entity {
+	return p.entityList[:p.enabled]
+}
+
+// hide moves the component of the entity behind the enabled components, where
+// queries do not look, taking it out of the group first. Nothing is done if
+// the component is missing or already hidden.
+func (p *componentStore[T]) hide(e Entity) {
+	if !p.isEnabled(p.index(e)) {
+		return
+	}
+	p.enabled--
+	if p.tags != nil {
+		p.tagBits.ClearBit(int(e.ID()))
+		setBit(&p.tags.inactive, int(e.ID()))
+		i := position(p.entityList[:p.enabled+1], e)
+		shift(p.entityList, i, p.enabled+position(p.entityList[p.enabled+1:], e))
+		return
+	}
+	if p.group != nil {
+		p.group.removed(e)
+	}
+	p.swap(p.index(e), p.enabled)
+}
+
+// show moves the component of the entity in front of the disabled components,
+// and into the group if it now has every owned component. Nothing is done if
+// the component is missing or already shown.
+func (p *componentStore[T]) show(e Entity) {
+	if p.tags != nil {
+		if id := int(e.ID()); hasBit(p.tags.inactive, id) {
+			p.tags.inactive.ClearBit(id)
+			setBit(&p.tagBits, id)
+			i := p.enabled + position(p.entityList[p.enabled:], e)
+			shift(p.entityList, i, position(p.entityList[:p.enabled], e))
+			p.enabled++
+		}
+		return
+	}
+	idx := p.index(e)
+	if idx < p.enabled {
+		return
+	}
+	p.swap(idx, p.enabled)
+	p.enabled++
+	if p.group != nil {
+		p.group.added(e)
+	}
+}
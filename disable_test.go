@@ -0,0 +1,237 @@
+package ecs_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestDisable(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[CombatTag](&world)
+	ecs.SetStorage[CombatTag](&world, ecs.TagStorage)
+
+	entities := make([]ecs.Entity, 8)
+	for i := range entities {
+		entities[i] = world.NewEntity()
+		ecs.Add(&world, entities[i], Position{x: float32(i)})
+		ecs.Add(&world, entities[i], Health{hp: i})
+		ecs.Add(&world, entities[i], CombatTag{})
+	}
+
+	sorted := func(es []ecs.Entity) []ecs.Entity {
+		slices.Sort(es)
+		return es
+	}
+	without := func(es []ecs.Entity, skip ...ecs.Entity) []ecs.Entity {
+		return slices.DeleteFunc(slices.Clone(es), func(e ecs.Entity) bool {
+			return slices.Contains(skip, e)
+		})
+	}
+
+	t.Run("Component", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.Disable[Position](&world, entities[2]), true)
+		testutil.AssertEqual(t, ecs.Disable[Position](&world, entities[2]), true)
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&world, entities[2]), false)
+		testutil.AssertEqual(t, ecs.IsEnabled[Health](&world, entities[2]), true)
+
+		es, ps := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(slices.Clone(es)), without(entities, entities[2])), true)
+		for i, e := range es {
+			testutil.AssertEqual(t, e, entities[int(ps[i].x)])
+		}
+		es = ecs.Query2[Position, Health](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), without(entities, entities[2])), true)
+		es = ecs.QueryExclude[Health, Position](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[2]}), true)
+
+		n := 0
+		for e := range ecs.Each2[Health, Position](&world) {
+			testutil.AssertEqual(t, e == entities[2], false)
+			n++
+		}
+		testutil.AssertEqual(t, n, len(entities)-1)
+		for e, c := range ecs.Each1Optional1[Health, Position](&world) {
+			testutil.AssertEqual(t, c.B == nil, e == entities[2])
+		}
+		ecs.ParallelEach2(&world, ecs.Parallel{}, func(e ecs.Entity, c struct {
+			A *Position
+			B *Health
+		}) {
+			if e == entities[2] {
+				t.Error("disabled component visited")
+			}
+		})
+
+		// The data is kept and can still be accessed directly.
+		p, ok := ecs.Get[Position](&world, entities[2])
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, p.x, float32(2))
+		testutil.AssertEqual(t, ecs.Set(&world, entities[2], Position{x: 2, y: 1}), true)
+		testutil.AssertEqual(t, world.Store(PositionID).Has(entities[2]), true)
+
+		es, ps = ecs.Disabled[Position](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[2]}), true)
+		testutil.AssertEqual(t, ps[0], Position{x: 2, y: 1})
+
+		testutil.AssertEqual(t, ecs.Enable[Position](&world, entities[2]), true)
+		es, _ = ecs.Query[Position](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(slices.Clone(es)), entities), true)
+		es, _ = ecs.Disabled[Position](&world)
+		testutil.AssertEqual(t, len(es), 0)
+	})
+
+	t.Run("Entity", func(t *testing.T) {
+		ecs.Disable[Health](&world, entities[4])
+		testutil.AssertEqual(t, world.DisableEntity(entities[3]), true)
+		testutil.AssertEqual(t, world.DisableEntity(entities[4]), true)
+		testutil.AssertEqual(t, world.IsEnabled(entities[3]), false)
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&world, entities[3]), false)
+
+		es := ecs.Query2[Position, Health](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), without(entities, entities[3], entities[4])), true)
+		es, _ = ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, without(entities, entities[3], entities[4])), true)
+
+		// Components added while the entity is disabled are hidden too.
+		ecs.Add(&world, entities[3], Velocity{})
+		es, _ = ecs.Query[Velocity](&world)
+		testutil.AssertEqual(t, len(es), 0)
+		es, _ = ecs.Disabled[Velocity](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[3]}), true)
+
+		// A component disabled on its own stays hidden.
+		testutil.AssertEqual(t, world.EnableEntity(entities[3]), true)
+		testutil.AssertEqual(t, world.EnableEntity(entities[4]), true)
+		testutil.AssertEqual(t, world.IsEnabled(entities[4]), true)
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&world, entities[4]), true)
+		testutil.AssertEqual(t, ecs.IsEnabled[Health](&world, entities[4]), false)
+		es = ecs.Query2[Position, Health](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), without(entities, entities[4])), true)
+		es, _ = ecs.Query[Velocity](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[3]}), true)
+
+		ecs.Enable[Health](&world, entities[4])
+		testutil.AssertEqual(t, len(ecs.Query2[Position, Health](&world)), len(entities))
+	})
+
+	t.Run("Tag", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.Disable[CombatTag](&world, entities[5]), true)
+		es, _ := ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, without(entities, entities[5])), true)
+		testutil.AssertEqual(t, len(ecs.Query2[Health, CombatTag](&world)), len(entities)-1)
+		testutil.AssertEqual(t, world.Store(CombatTagID).Has(entities[5]), true)
+		es, _ = ecs.Disabled[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[5]}), true)
+
+		// A disabled tag is removed like any other.
+		testutil.AssertEqual(t, ecs.Remove[CombatTag](&world, entities[5]), true)
+		testutil.AssertEqual(t, world.Store(CombatTagID).Has(entities[5]), false)
+		es, _ = ecs.Disabled[CombatTag](&world)
+		testutil.AssertEqual(t, len(es), 0)
+		ecs.Add(&world, entities[5], CombatTag{})
+		testutil.AssertEqual(t, ecs.IsEnabled[CombatTag](&world, entities[5]), true)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		ecs.Disable[Position](&world, entities[1])
+		ecs.Disable[Position](&world, entities[6])
+		testutil.AssertEqual(t, ecs.Remove[Position](&world, entities[1]), true)
+		testutil.AssertEqual(t, ecs.Remove[Position](&world, entities[0]), true)
+
+		es, ps := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(slices.Clone(es)), without(entities, entities[0], entities[1], entities[6])), true)
+		for i, e := range es {
+			testutil.AssertEqual(t, e, entities[int(ps[i].x)])
+		}
+		es, _ = ecs.Disabled[Position](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[6]}), true)
+
+		// Adding the component again enables it.
+		ecs.Add(&world, entities[1], Position{x: 1})
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&world, entities[1]), true)
+		ecs.Add(&world, entities[0], Position{x: 0})
+		ecs.Enable[Position](&world, entities[6])
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		// The group is built with a member already disabled.
+		ecs.Disable[Health](&world, entities[7])
+		ecs.Group2[Position, Health](&world)
+		world.DisableEntity(entities[0])
+
+		es, ps, hs := ecs.Group2[Position, Health](&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(slices.Clone(es)), entities[1:7]), true)
+		for i, e := range es {
+			testutil.AssertEqual(t, e, entities[int(ps[i].x)])
+			testutil.AssertEqual(t, e, entities[hs[i].hp])
+		}
+
+		ecs.Enable[Health](&world, entities[7])
+		world.EnableEntity(entities[0])
+		es, _, _ = ecs.Group2[Position, Health](&world)
+		testutil.AssertEqual(t, len(es), len(entities))
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		ecs.Disable[Velocity](&world, entities[3])
+		for _, e := range entities[4:] {
+			ecs.Add(&world, e, Velocity{x: -float32(e.ID())})
+		}
+		testutil.AssertEqual(t, ecs.Sort(&world, func(a, b *Velocity) bool {
+			return a.x < b.x
+		}), true)
+		es, _ := ecs.Query[Velocity](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[7], entities[6], entities[5], entities[4]}), true)
+		es, _ = ecs.Disabled[Velocity](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[3]}), true)
+
+		testutil.AssertEqual(t, ecs.SortLike[Position, Velocity](&world), true)
+		es, _ = ecs.Disabled[Velocity](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[3]}), true)
+	})
+
+	t.Run("QueryBuilder", func(t *testing.T) {
+		world.DisableEntity(entities[1])
+		es := ecs.NewQueryBuilder().With(VelocityID).Entities(&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), entities[4:]), true)
+		es = ecs.NewQueryBuilder().With(VelocityID).IncludeDisabled().Entities(&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), entities[3:]), true)
+		es = ecs.NewQueryBuilder().With(HealthID).Without(VelocityID).Entities(&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), []ecs.Entity{entities[0], entities[2], entities[3]}), true)
+		es = ecs.NewQueryBuilder().Entities(&world)
+		testutil.AssertEqual(t, slices.Contains(es, entities[1]), false)
+		es = ecs.NewQueryBuilder().IncludeDisabled().Entities(&world)
+		testutil.AssertEqual(t, len(es), len(entities))
+		world.EnableEntity(entities[1])
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		e := world.NewEntity()
+		testutil.AssertEqual(t, ecs.DisableE[Score](&world, e), ecs.ErrNotInitialized)
+		testutil.AssertEqual(t, ecs.DisableE[Position](&world, e), ecs.ErrMissingComponent)
+		testutil.AssertEqual(t, ecs.EnableE[Position](&world, e), ecs.ErrMissingComponent)
+		ecs.Add(&world, e, Position{})
+		world.DisableEntity(e)
+		world.DestroyEntity(e)
+		testutil.AssertEqual(t, ecs.DisableE[Position](&world, e), ecs.ErrStaleEntity)
+		testutil.AssertEqual(t, world.EnableEntity(e), false)
+		testutil.AssertEqual(t, world.IsEnabled(e), false)
+
+		// The recycled ID starts out enabled.
+		r := world.NewEntity()
+		testutil.AssertEqual(t, r.ID(), e.ID())
+		testutil.AssertEqual(t, world.IsEnabled(r), true)
+		ecs.Add(&world, r, Position{})
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&world, r), true)
+	})
+}
@@ -3,8 +3,25 @@
 // components for highly dynamic games.
 //
 // This library simply provides a way to manage entities and their components
-// through the World struct and then query those components. Serialization,
-// scheduling, event handling etc. is out of the scope for this library.
+// through the World struct and then query those components. Worlds can be
+// saved to a binary snapshot for components which opt in with a Codec, global
+// data can be kept as typed resources, and systems can communicate through
+// typed event queues. Scheduling is left to the opt-in scheduler package.
+//
+// Components and whole entities can be disabled, which hides them from queries
+// and iterators while keeping their data. See Disable and World.DisableEntity.
+//
+// A World is not safe for concurrent use unless it is created with
+// WorldOptions.Concurrent. A concurrent world guards its entities and each
+// component store with a separate lock. Query functions read lock their stores
+// while running, so results are consistent snapshots, and Query returns copies
+// of the packed arrays. Iterators hold their read locks until the loop ends,
+// so the loop body must not access the world other than through the yielded
+// components. Use a CommandBuffer instead.
+//
+// Components must be initialized, and ticks advanced, while no other goroutine
+// uses the world. Observers, groups, the hierarchy and GetMut are refused by a
+// concurrent world. Events, resources and snapshots are not guarded.
 //
 // Design is heavily inspired by the research done by dakom on EnTT & Shipyard.
 // https://gist.github.com/dakom/82551fff5d2b843cbe1601bbaff2acbf
@@ -15,16 +32,45 @@ package ecs
 
 import "unsafe"
 
-const (
-	MAX_ENTITIES   uint32      = 16777216
-	MAX_COMPONENTS ComponentID = 255
-)
+const MAX_COMPONENTS ComponentID = 65535
 
 // World contains all entities and their components.
+//
+// Entities, component stores, events and resources are shared by reference, so
+// copies of a World refer to the same data.
 type World struct {
-	entities       entityManager
-	components     []any
+	entities       *entityManager
+	components     []Store
 	ComponentCount int
+
+	// componentLimit bounds the IDs of the component table, which grows to fit
+	// the highest ID in use.
+	componentLimit ComponentID
+
+	// initialized lists the IDs of the initialized component stores, so the
+	// table does not need to be scanned.
+	initialized []ComponentID
+
+	// types maps each initialized component type to its ID. It is the only way
+	// component types are resolved after initialization.
+	types *typeTable
+
+	// tick is the current tick used for change detection. It is shared with
+	// every component store.
+	tick *Tick
+
+	// events holds the event queues keyed by event type.
+	events map[any]eventQueue
+
+	// resources holds a pointer to each resource keyed by its type.
+	resources map[any]any
+
+	// hierarchy indexes the children of each entity, if initialized.
+	hierarchy *hierarchy
+
+	// concurrent reports whether the entities and component stores are
+	// guarded by locks.
+	concurrent bool
 }
 
 // WorldOptions lists the option parameters required to create a World.
@@ -37,16 +83,35 @@ type WorldOptions struct {
 	// Bounded by EntityLimit.
 	RecycleLimit uint32
 
-	// ComponentLimit is the maximum number of component types.
-	// Bounded by MAX_COMPONENTS.
+	// ComponentLimit bounds the IDs of component types. The component table
+	// grows as components are initialized, so a high limit costs nothing until
+	// used. Bounded by MAX_COMPONENTS.
 	ComponentLimit ComponentID
+
+	// Concurrent guards the entities and every component store with a lock so
+	// that entities may be created and destroyed, and components added,
+	// removed and read, from several goroutines. See the package documentation
+	// for the rules of a concurrent world.
+	Concurrent bool
 }
 
 // NewWorld creates a new world with the given options.
 func NewWorld(opts WorldOptions) World {
+	tick := Tick(1)
+	entities := newEntityManager(opts.EntityLimit, opts.RecycleLimit)
+	if opts.Concurrent {
+		entities.lock = new(rwLock)
+	}
 	return World{
-		entities:   newEntityManager(opts.EntityLimit, opts.RecycleLimit),
-		components: make([]any, opts.ComponentLimit),
+		entities:       entities,
+		components:     make([]Store, 0),
+		componentLimit: opts.ComponentLimit,
+		initialized:    make([]ComponentID, 0),
+		types:          new(typeTable),
+		tick:           &tick,
+		events:         make(map[any]eventQueue),
+		resources:      make(map[any]any),
+		concurrent:     opts.Concurrent,
 	}
 }
 
@@ -57,16 +122,58 @@ func (w *World) NewEntity() Entity {
 	return w.entities.CreateEntity()
 }
 
-// DestroyEntity recycles the associated Entity ID.
+// NewEntityE is NewEntity returning ErrEntityLimit upon failure.
+func (w *World) NewEntityE() (Entity, error) {
+	e := w.entities.CreateEntity()
+	if e == 0 {
+		return 0, ErrEntityLimit
+	}
+	return e, nil
+}
+
+// IsAlive reports whether the entity is living. Stale references to an entity
+// which was destroyed, including those whose ID has since been recycled, are
+// not alive.
+func (w *World) IsAlive(e Entity) bool {
+	return w.entities.IsAlive(e)
+}
+
+// DestroyEntity removes the entity from every initialized component store and
+// then recycles the associated Entity ID. Dead or stale entities are refused.
+// Children are orphaned or destroyed according to the hierarchy DeletePolicy.
+//
+// Removal opts out of cleaning unused page memory. Use Sweep periodically if
+// destruction is frequent and space is a premium.
 //
-// Warning: It is up to the caller to ensure that the entity is removed from
-// each component store using the remove function to prevent a memory leak.
+// Time Complexity: O(C) where C is the number of initialized components.
 func (w *World) DestroyEntity(e Entity) bool {
-	return w.entities.RecycleEntity(e)
+	// Nothing is added to the entity until it is recycled. The hierarchy and
+	// observers are refused by a concurrent world, so they never reach the
+	// lock while it is held.
+	w.entities.lock.Lock()
+	defer w.entities.lock.Unlock()
+	if !w.entities.isAlive(e) {
+		return false
+	}
+	if w.hierarchy != nil {
+		w.hierarchy.destroyed(w, e)
+	}
+	for _, id := range w.initialized {
+		w.components[id].Remove(e)
+	}
+	return w.entities.recycle(e)
+}
+
+// DestroyEntityE is DestroyEntity returning ErrStaleEntity upon failure.
+func (w *World) DestroyEntityE(e Entity) error {
+	if !w.DestroyEntity(e) {
+		return ErrStaleEntity
+	}
+	return nil
 }
 
 func (w *World) EntityCount() int {
-	return int(w.entities.size)
+	return w.entities.Len()
 }
 
 func (w *World) EntityLimit() int {
@@ -78,7 +185,31 @@ func (w *World) RecycleLimit() int {
 }
 
 func (w *World) ComponentLimit() int {
-	return cap(w.components)
+	return int(w.componentLimit)
+}
+
+// Store returns the type-erased component store for the given ID.
+//
+// The store is nil if the component was not initialized.
+func (w *World) Store(id ComponentID) Store {
+	if int(id) >= len(w.components) {
+		return nil
+	}
+	return w.components[id]
+}
+
+// typeKey returns a value which is unique to the type T. It is used to key
+// maps by type without reflection.
+func typeKey[T any]() any {
+	return (*T)(nil)
+}
+
+// typeWord returns the address of the runtime type of *T, which is unique to
+// the type T and fixed for the life of the program. It is read from the type
+// word of the interface returned by typeKey, so nothing of T is touched.
+func typeWord[T any]() uintptr {
+	key := typeKey[T]()
+	return (*[2]uintptr)(unsafe.Pointer(&key))[0]
 }
 
 // MemUsage for the world does not include the memory taken by the component
@@ -86,7 +217,9 @@ func (w *World) ComponentLimit() int {
 func (w *World) MemUsage() uintptr {
 	size := unsafe.Sizeof(*w)
 	size += w.entities.MemUsage()
-	size += unsafe.Sizeof(w.components)
+	size += uintptr(cap(w.components)) * unsafe.Sizeof(Store(nil))
+	size += uintptr(cap(w.initialized)) * unsafe.Sizeof(ComponentID(0))
 	size += unsafe.Sizeof(w.ComponentCount)
+	size += unsafe.Sizeof(*w.tick)
 	return size
 }
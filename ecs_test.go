@@ -1,6 +1,7 @@
 package ecs_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jdavasligil/go-ecs"
@@ -36,3 +37,203 @@ func TestEcs(t *testing.T) {
 		testutil.AssertEqual(t, ecs.Remove[Health](&world, player), false)
 	})
 }
+
+func TestDestroyEntity(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[CombatTag](&world)
+
+	player := world.NewEntity()
+	ecs.Add(&world, player, Position{1.0, 2.0, 3.0})
+	ecs.Add(&world, player, Health{16})
+
+	testutil.AssertEqual(t, world.DestroyEntity(player), true)
+
+	_, ok := ecs.Get[Health](&world, player)
+	testutil.AssertEqual(t, ok, false)
+	es, _ := ecs.Query[Position](&world)
+	testutil.AssertEqual(t, len(es), 0)
+
+	recycled := world.NewEntity()
+	testutil.AssertEqual(t, recycled.ID(), player.ID())
+	_, ok = ecs.Get[Health](&world, recycled)
+	testutil.AssertEqual(t, ok, false)
+}
+
+func TestStaleEntity(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Health](&world)
+
+	stale := world.NewEntity()
+	testutil.AssertEqual(t, world.IsAlive(stale), true)
+	testutil.AssertEqual(t, world.DestroyEntity(stale), true)
+	testutil.AssertEqual(t, world.IsAlive(stale), false)
+	testutil.AssertEqual(t, world.DestroyEntity(stale), false)
+	testutil.AssertEqual(t, ecs.Add(&world, stale, Health{1}), false)
+
+	fresh := world.NewEntity()
+	testutil.AssertEqual(t, fresh.ID(), stale.ID())
+	testutil.AssertEqual(t, world.IsAlive(fresh), true)
+	testutil.AssertEqual(t, ecs.Add(&world, fresh, Health{2}), true)
+
+	_, ok := ecs.Get[Health](&world, stale)
+	testutil.AssertEqual(t, ok, false)
+	_, ok = ecs.GetMut[Health](&world, stale)
+	testutil.AssertEqual(t, ok, false)
+	testutil.AssertEqual(t, ecs.Remove[Health](&world, stale), false)
+	testutil.AssertEqual(t, world.DestroyEntity(stale), false)
+
+	hp, ok := ecs.Get[Health](&world, fresh)
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, hp.hp, 2)
+	testutil.AssertEqual(t, world.IsAlive(0), false)
+}
+
+func TestStore(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+
+	player := world.NewEntity()
+	ecs.Add(&world, player, Position{1.0, 2.0, 3.0})
+
+	store := world.Store(PositionID)
+	testutil.AssertEqual(t, store != nil, true)
+	testutil.AssertEqual(t, store.Has(player), true)
+	testutil.AssertEqual(t, store.Len(), 1)
+	testutil.AssertEqual(t, store.Entities()[0], player)
+	testutil.AssertEqual(t, store.MemUsage(), ecs.MemUsage[Position](&world))
+	testutil.AssertEqual(t, store.Remove(player), true)
+	testutil.AssertEqual(t, store.Len(), 0)
+
+	testutil.AssertEqual(t, world.Store(HealthID) == nil, true)
+
+	// A stale entity must not reach the component of the entity which took
+	// its ID.
+	ecs.Initialize[CombatTag](&world)
+	ecs.SetStorage[CombatTag](&world, ecs.TagStorage)
+	world.DestroyEntity(player)
+	enemy := world.NewEntity()
+	testutil.AssertEqual(t, enemy.ID(), player.ID())
+	ecs.Add(&world, enemy, Position{4.0, 5.0, 6.0})
+	ecs.Add(&world, enemy, CombatTag{})
+	for _, id := range []ecs.ComponentID{PositionID, CombatTagID} {
+		store = world.Store(id)
+		testutil.AssertEqual(t, store.Has(player), false)
+		testutil.AssertEqual(t, store.Component(player) == nil, true)
+		testutil.AssertEqual(t, store.Remove(player), false)
+		testutil.AssertEqual(t, store.Copy(player, enemy), false)
+		testutil.AssertEqual(t, store.Has(enemy), true)
+	}
+	ecs.Disable[CombatTag](&world, enemy)
+	testutil.AssertEqual(t, world.Store(CombatTagID).Has(player), false)
+	testutil.AssertEqual(t, world.Store(CombatTagID).Has(enemy), true)
+}
+
+func TestRegister(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 8,
+	})
+	testutil.AssertEqual(t, ecs.Initialize[Position](&world), true)
+	testutil.AssertEqual(t, ecs.Initialize[Misnumbered](&world), false)
+
+	id, ok := ecs.Register[Name](&world)
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, id, ecs.ComponentID(7))
+	again, _ := ecs.Register[Name](&world)
+	testutil.AssertEqual(t, again, id)
+	id, _ = ecs.Register[Health](&world)
+	testutil.AssertEqual(t, id, HealthID)
+	id, _ = ecs.Register[Inventory](&world)
+	testutil.AssertEqual(t, id, InventoryID)
+	id, _ = ecs.ComponentIDOf[Position](&world)
+	testutil.AssertEqual(t, id, PositionID)
+	_, ok = ecs.ComponentIDOf[Velocity](&world)
+	testutil.AssertEqual(t, ok, false)
+
+	e := world.NewEntity()
+	testutil.AssertEqual(t, ecs.Add(&world, e, Name{"goblin"}), true)
+	testutil.AssertEqual(t, ecs.Add(&world, e, Position{}), true)
+	testutil.AssertEqual(t, ecs.Add(&world, e, Inventory{}), true)
+	testutil.AssertEqual(t, ecs.Add(&world, e, Velocity{}), false)
+	name, ok := ecs.Get[Name](&world, e)
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, name.text, "goblin")
+	testutil.AssertEqual(t, len(ecs.Query2[Name, Position](&world)), 1)
+	testutil.AssertEqual(t, world.Store(7).Has(e), true)
+	_, ok = ecs.GetMut[Inventory](&world, e)
+	testutil.AssertEqual(t, ok, true)
+}
+
+func TestComponentLimit(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: ecs.MAX_COMPONENTS,
+	})
+	testutil.AssertEqual(t, ecs.Initialize[Position](&world), true)
+	testutil.AssertEqual(t, ecs.Initialize[Distant](&world), true)
+	testutil.AssertEqual(t, world.ComponentCount, 2)
+
+	e := world.NewEntity()
+	ecs.Add(&world, e, Position{})
+	ecs.Add(&world, e, Distant{})
+	testutil.AssertEqual(t, len(ecs.Query2[Position, Distant](&world)), 1)
+	testutil.AssertEqual(t, world.Store(DistantID).Has(e), true)
+	testutil.AssertEqual(t, world.DestroyEntity(e), true)
+	testutil.AssertEqual(t, world.Store(DistantID).Len(), 0)
+
+	small := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: DistantID,
+	})
+	testutil.AssertEqual(t, ecs.Initialize[Distant](&small), false)
+	testutil.AssertEqual(t, small.Store(DistantID) == nil, true)
+}
+
+func TestErrors(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1,
+		RecycleLimit:   1,
+		ComponentLimit: 4,
+	})
+	testutil.AssertEqual(t, ecs.InitializeE[Position](&world), nil)
+	testutil.AssertEqual(t, ecs.InitializeE[Position](&world), ecs.ErrAlreadyInitialized)
+	testutil.AssertEqual(t, errors.Is(ecs.InitializeE[Misnumbered](&world), ecs.ErrDuplicateID), true)
+	testutil.AssertEqual(t, errors.Is(ecs.InitializeE[Distant](&world), ecs.ErrComponentLimit), true)
+
+	e, err := world.NewEntityE()
+	testutil.AssertEqual(t, err, nil)
+	_, err = world.NewEntityE()
+	testutil.AssertEqual(t, err, ecs.ErrEntityLimit)
+
+	testutil.AssertEqual(t, ecs.AddE(&world, e, Velocity{}), ecs.ErrNotInitialized)
+	testutil.AssertEqual(t, ecs.AddE(&world, e, Position{}), nil)
+	testutil.AssertEqual(t, ecs.AddE(&world, e, Position{}), ecs.ErrDuplicateComponent)
+	testutil.AssertEqual(t, ecs.SetE(&world, e, Position{1.0, 0.0, 0.0}), nil)
+	testutil.AssertEqual(t, ecs.RemoveE[Position](&world, e), nil)
+	testutil.AssertEqual(t, ecs.RemoveE[Position](&world, e), ecs.ErrMissingComponent)
+	testutil.AssertEqual(t, ecs.RemoveAndCleanE[Position](&world, e), ecs.ErrMissingComponent)
+	testutil.AssertEqual(t, ecs.SetE(&world, e, Position{}), ecs.ErrMissingComponent)
+
+	testutil.AssertEqual(t, world.DestroyEntityE(e), nil)
+	testutil.AssertEqual(t, world.DestroyEntityE(e), ecs.ErrStaleEntity)
+	testutil.AssertEqual(t, ecs.AddE(&world, e, Position{}), ecs.ErrStaleEntity)
+	testutil.AssertEqual(t, ecs.RemoveE[Position](&world, e), ecs.ErrStaleEntity)
+	testutil.AssertEqual(t, ecs.RemoveAndCleanE[Position](&world, e), ecs.ErrStaleEntity)
+}
@@ -9,6 +9,11 @@ const (
 	HealthID
 	CombatTagID
 	DeadTagID
+	ScoreID
+	InventoryID
+
+	// DistantID is beyond the range of a byte.
+	DistantID ecs.ComponentID = 1000
 )
 
 // Components
@@ -25,6 +30,25 @@ type Position struct {
 type Health struct {
 	hp int
 }
+type Score struct {
+	Points int32
+	Combo  uint8
+}
+type Inventory struct {
+	slots [128]uint32
+}
+
+type Distant struct {
+	far bool
+}
+
+// Registered components have no ID method.
+type Name struct {
+	text string
+}
+
+// Misnumbered shares the ID of Position by mistake.
+type Misnumbered struct{}
 
 // Tags
 type CombatTag struct{}
@@ -36,3 +60,8 @@ func (c Velocity) ID() ecs.ComponentID  { return VelocityID }
 func (c Position) ID() ecs.ComponentID  { return PositionID }
 func (c Health) ID() ecs.ComponentID    { return HealthID }
 func (c CombatTag) ID() ecs.ComponentID { return CombatTagID }
+func (c Score) ID() ecs.ComponentID     { return ScoreID }
+func (c Inventory) ID() ecs.ComponentID { return InventoryID }
+func (c Distant) ID() ecs.ComponentID   { return DistantID }
+
+func (c Misnumbered) ID() ecs.ComponentID { return PositionID }
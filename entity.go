@@ -1,13 +1,26 @@
+//go:build !ecs64
+
 package ecs
 
 // [ID                    ][Version]
 // ########################VVVVVVVV
 // 00000000000000000000000011111111
+//
+// Build with the ecs64 tag for 64-bit entities.
+
+// MAX_ENTITIES is the size of the entity ID space.
+const MAX_ENTITIES uint32 = 16777216
 
 // Entity is a unique ID which corresponds to exactly one game object.
 // It is used to reference a collection of Components (data).
 type Entity uint32
 
+// Generation is the version of an entity. It rolls over after 256 versions.
+type Generation = uint8
+
+// maxGeneration is the last version before rolling over.
+const maxGeneration Generation = 255
+
 // NewEntity requires that the provided id < 16777215.
 func newEntity(id uint32) Entity {
 	return Entity(id << 8)
@@ -15,8 +28,8 @@ func newEntity(id uint32) Entity {
 
 // Version returns the current generation of the entity. Used for comparison
 // to verify that an existing reference is invalid (entity was deleted).
-func (e *Entity) Version() uint8 {
-	return uint8(*e)
+func (e *Entity) Version() Generation {
+	return Generation(*e)
 }
 
 // ID returns the actual unique ID of the Entity. This shall be immutable.
@@ -26,8 +39,8 @@ func (e *Entity) ID() uint32 {
 
 // Next updates the Entity's Version (Generation) upon deletion in place.
 func (e *Entity) next() {
-	if e.Version() == 255 {
-		*e -= 255
+	if e.Version() == maxGeneration {
+		*e -= Entity(maxGeneration)
 	} else {
 		*e += 1
 	}
@@ -0,0 +1,50 @@
+//go:build ecs64
+
+package ecs
+
+import "math"
+
+// [ID                            ][Version                       ]
+// ################################VVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVV
+// 0000000000000000000000000000000011111111111111111111111111111111
+//
+// The 64-bit layout is chosen with the ecs64 build tag. Long running worlds
+// which recycle the same IDs many times should prefer it.
+
+// MAX_ENTITIES is the size of the entity ID space.
+const MAX_ENTITIES uint32 = math.MaxUint32
+
+// Entity is a unique ID which corresponds to exactly one game object.
+// It is used to reference a collection of Components (data).
+type Entity uint64
+
+// Generation is the version of an entity. It rolls over after 2^32 versions.
+type Generation = uint32
+
+// maxGeneration is the last version before rolling over.
+const maxGeneration Generation = math.MaxUint32
+
+// NewEntity requires that the provided id < 4294967295.
+func newEntity(id uint32) Entity {
+	return Entity(id) << 32
+}
+
+// Version returns the current generation of the entity. Used for comparison
+// to verify that an existing reference is invalid (entity was deleted).
+func (e *Entity) Version() Generation {
+	return Generation(*e)
+}
+
+// ID returns the actual unique ID of the Entity. This shall be immutable.
+func (e *Entity) ID() uint32 {
+	return uint32(*e >> 32)
+}
+
+// Next updates the Entity's Version (Generation) upon deletion in place.
+func (e *Entity) next() {
+	if e.Version() == maxGeneration {
+		*e -= Entity(maxGeneration)
+	} else {
+		*e += 1
+	}
+}
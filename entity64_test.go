@@ -0,0 +1,41 @@
+//go:build ecs64
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestEntity64(t *testing.T) {
+	var id uint32 = (1 << 31) + 5
+	e := newEntity(id)
+	testutil.AssertEqual(t, e.ID(), id)
+	testutil.AssertEqual(t, e.Version(), Generation(0))
+
+	for i := 0; i < 300; i++ {
+		e.next()
+	}
+	testutil.AssertEqual(t, e.ID(), id)
+	testutil.AssertEqual(t, e.Version(), Generation(300))
+
+	e = newEntity(id) | Entity(maxGeneration)
+	e.next()
+	testutil.AssertEqual(t, e.ID(), id)
+	testutil.AssertEqual(t, e.Version(), Generation(0))
+}
+
+func TestEntityManager64(t *testing.T) {
+	em := newEntityManager(1, 1)
+	first := em.CreateEntity()
+	em.RecycleEntity(first)
+	for i := 0; i < 300; i++ {
+		em.RecycleEntity(em.CreateEntity())
+	}
+	e := em.CreateEntity()
+	testutil.AssertEqual(t, e.ID(), first.ID())
+	testutil.AssertEqual(t, e.Version(), Generation(301))
+	testutil.AssertEqual(t, em.IsAlive(first), false)
+	testutil.AssertEqual(t, em.IsAlive(e), true)
+}
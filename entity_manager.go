@@ -1,7 +1,7 @@
 package ecs
 
 import (
-	"log"
+	"iter"
 	"unsafe"
 
 	"github.com/jdavasligil/go-ecs/pkg/queue"
@@ -13,65 +13,264 @@ type entityManager struct {
 	MaxRecycle  uint32
 
 	// Queue of discarded entity IDs for recycling.
-	bin queue.Queue[Entity]
+	bin *queue.RingBuffer[Entity]
 
 	// Total living entities used to enforce a limit on max entities.
 	size uint32
 
 	// ID for the next entity to be created if the recycle bin is empty.
 	next uint32
+
+	// versions holds the current version of every ID handed out. The table is
+	// indexed by the entity id itself.
+	versions []Generation
+
+	// alive marks which IDs belong to a living entity. The table is indexed by
+	// the entity id itself.
+	alive []bool
+
+	// disabled marks which living entities are hidden from queries. The table
+	// is indexed by the entity id itself.
+	disabled []bool
+
+	// reserved marks which IDs are set aside for the placeholders of a
+	// CommandBuffer. The table is indexed by the entity id itself.
+	reserved []bool
+
+	// pending is the number of reserved IDs. They count toward the limit on
+	// max entities, but are not living.
+	pending uint32
+
+	// lock makes creation and recycling atomic in a concurrent world,
+	// otherwise it is nil.
+	lock *rwLock
 }
 
-func newEntityManager(entityLimit uint32, recycleLimit uint32) entityManager {
+func newEntityManager(entityLimit uint32, recycleLimit uint32) *entityManager {
 	elim := min(MAX_ENTITIES, entityLimit)
 	rlim := min(elim, recycleLimit)
-	return entityManager{
+	return &entityManager{
 		MaxEntities: elim,
 		MaxRecycle:  rlim,
 		bin:         queue.NewRingBuffer[Entity](int(rlim)),
 		size:        0,
 		next:        1,
+		versions:    make([]Generation, 1),
+		alive:       make([]bool, 1),
+		disabled:    make([]bool, 1),
+		reserved:    make([]bool, 1),
 	}
 }
 
 // Creates an entity by recycling or incrementing to the next ID.
+//
+// The null entity is returned when the manager is full.
 func (em *entityManager) CreateEntity() Entity {
-	if em.size == em.MaxEntities {
-		log.Printf("entityManager: Failed to create entity - manager is full.")
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	if em.size+em.pending == em.MaxEntities {
 		return 0
 	}
 
-	var entity Entity
+	entity := em.take()
+	em.alive[entity.ID()] = true
+	em.size++
+
+	return entity
+}
 
-	if em.bin.IsEmpty() {
-		entity = newEntity(em.next)
-		em.next += 1
-	} else {
-		entity = em.bin.Pop()
+// take hands out an ID by recycling or incrementing to the next ID. The
+// caller must hold the lock and check the limit.
+func (em *entityManager) take() Entity {
+	if !em.bin.IsEmpty() {
+		return em.bin.Pop()
 	}
+	entity := newEntity(em.next)
+	em.next += 1
+	em.versions = append(em.versions, entity.Version())
+	em.alive = append(em.alive, false)
+	em.disabled = append(em.disabled, false)
+	em.reserved = append(em.reserved, false)
+	return entity
+}
 
-	em.size++
+// Reserve sets aside an entity for a placeholder of a CommandBuffer. It is
+// not living until revived, and no other entity is given its ID until then.
+//
+// The null entity is returned when the manager is full.
+func (em *entityManager) Reserve() Entity {
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	if em.size+em.pending == em.MaxEntities {
+		return 0
+	}
+
+	entity := em.take()
+	em.reserved[entity.ID()] = true
+	em.pending++
 
 	return entity
 }
 
+// Revive brings a reserved entity to life. Entities which are not reserved
+// are refused.
+func (em *entityManager) Revive(entity Entity) bool {
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	if !em.isReserved(entity) {
+		return false
+	}
+
+	em.reserved[entity.ID()] = false
+	em.pending--
+	em.alive[entity.ID()] = true
+	em.size++
+
+	return true
+}
+
+// Unreserve returns a reserved entity which was never revived to the recycle
+// bin. Entities which are not reserved are ignored.
+func (em *entityManager) Unreserve(entity Entity) {
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	if !em.isReserved(entity) {
+		return
+	}
+
+	em.reserved[entity.ID()] = false
+	em.pending--
+	entity.next()
+	em.versions[entity.ID()] = entity.Version()
+	em.bin.Push(entity)
+}
+
+func (em *entityManager) isReserved(entity Entity) bool {
+	id := entity.ID()
+	if int(id) >= len(em.reserved) {
+		return false
+	}
+	return em.reserved[id] && em.versions[id] == entity.Version()
+}
+
 // RecycleEntity marks the entity as deleted and pushes it to the recycle bin.
+// Dead or stale entities are refused.
 //
 // The component data must also be deleted by removing that entity from each
 // associated component store handled by the component manager.
 func (em *entityManager) RecycleEntity(entity Entity) bool {
-	if em.size == 0 {
-		log.Printf("entityManager: Failed to recycle entity - manager is empty.")
+	em.lock.Lock()
+	defer em.lock.Unlock()
+	return em.recycle(entity)
+}
+
+func (em *entityManager) recycle(entity Entity) bool {
+	if !em.isAlive(entity) {
 		return false
 	}
 
 	entity.next()
+	em.versions[entity.ID()] = entity.Version()
+	em.alive[entity.ID()] = false
+	em.disabled[entity.ID()] = false
 	em.bin.Push(entity)
 	em.size -= 1
 
 	return true
 }
 
+// IsAlive reports whether the entity is living and its version is current.
+func (em *entityManager) IsAlive(entity Entity) bool {
+	if em.lock != nil {
+		return em.isAliveLocked(entity)
+	}
+	return em.isAlive(entity)
+}
+
+func (em *entityManager) isAliveLocked(entity Entity) bool {
+	em.lock.RLock()
+	defer em.lock.RUnlock()
+	return em.isAlive(entity)
+}
+
+func (em *entityManager) isAlive(entity Entity) bool {
+	id := entity.ID()
+	if int(id) >= len(em.alive) {
+		return false
+	}
+	return em.alive[id] && em.versions[id] == entity.Version()
+}
+
+// Hold read locks the entities in a concurrent world and reports whether the
+// entity is living. No entity is created, destroyed, enabled or disabled until
+// Release is called, which must happen whatever the result.
+//
+// The entities are locked before any component store. Hence a store may be
+// changed while holding them, but never the other way around.
+func (em *entityManager) Hold(entity Entity) bool {
+	em.lock.RLock()
+	return em.isAlive(entity)
+}
+
+// Release undoes Hold.
+func (em *entityManager) Release() {
+	em.lock.RUnlock()
+}
+
+// setEnabled enables or disables the entity. Dead or stale entities are
+// refused. The caller must hold the write lock.
+func (em *entityManager) setEnabled(entity Entity, enabled bool) bool {
+	if !em.isAlive(entity) {
+		return false
+	}
+	em.disabled[entity.ID()] = !enabled
+	return true
+}
+
+// isEnabled reports whether the entity with the ID of the given entity is not
+// disabled. The version is not checked. The caller must hold the lock.
+func (em *entityManager) isEnabled(entity Entity) bool {
+	id := int(entity.ID())
+	return id >= len(em.disabled) || !em.disabled[id]
+}
+
+// Len returns the number of living entities.
+func (em *entityManager) Len() int {
+	em.lock.RLock()
+	n := em.size
+	em.lock.RUnlock()
+	return int(n)
+}
+
+// Collect returns the living entities in order of ID. Disabled entities are
+// left out unless included.
+func (em *entityManager) Collect(includeDisabled bool) []Entity {
+	em.lock.RLock()
+	defer em.lock.RUnlock()
+	es := make([]Entity, 0, em.size)
+	for e := range em.entities() {
+		if includeDisabled || em.isEnabled(e) {
+			es = append(es, e)
+		}
+	}
+	return es
+}
+
+func (em *entityManager) entities() iter.Seq[Entity] {
+	return func(yield func(Entity) bool) {
+		for id, alive := range em.alive {
+			if alive && !yield(em.entity(uint32(id))) {
+				return
+			}
+		}
+	}
+}
+
+// entity returns the entity with the given ID at its current version.
+func (em *entityManager) entity(id uint32) Entity {
+	return newEntity(id) | Entity(em.versions[id])
+}
+
 func (em *entityManager) MemUsage() uintptr {
 	size := unsafe.Sizeof(*em)
 	size += unsafe.Sizeof(em.MaxEntities)
@@ -79,5 +278,14 @@ func (em *entityManager) MemUsage() uintptr {
 	size += em.bin.MemUsage()
 	size += unsafe.Sizeof(em.size)
 	size += unsafe.Sizeof(em.next)
+	size += unsafe.Sizeof(em.versions)
+	size += uintptr(cap(em.versions)) * unsafe.Sizeof(Generation(0))
+	size += unsafe.Sizeof(em.alive)
+	size += uintptr(cap(em.alive))
+	size += unsafe.Sizeof(em.disabled)
+	size += uintptr(cap(em.disabled))
+	size += unsafe.Sizeof(em.reserved)
+	size += uintptr(cap(em.reserved))
+	size += unsafe.Sizeof(em.pending)
 	return size
 }
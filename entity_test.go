@@ -12,8 +12,8 @@ func testEntity(t *testing.T) {
 	if id != e.ID() {
 		t.Errorf("Expected: %d, Got: %d", id, e.ID())
 	}
-	if uint8(0) != e.Version() {
-		t.Errorf("Expected: %d, Got: %d", uint8(0), e.Version())
+	if Generation(0) != e.Version() {
+		t.Errorf("Expected: %d, Got: %d", Generation(0), e.Version())
 	}
 
 	// Test Next
@@ -21,18 +21,17 @@ func testEntity(t *testing.T) {
 	if id != e.ID() {
 		t.Errorf("Expected: %d, Got: %d", id, e.ID())
 	}
-	if uint8(1) != e.Version() {
-		t.Errorf("Expected: %d, Got: %d", uint8(1), e.Version())
+	if Generation(1) != e.Version() {
+		t.Errorf("Expected: %d, Got: %d", Generation(1), e.Version())
 	}
 
 	// Test Rollover
-	for i := 0; i < 255; i++ {
-		e.next()
-	}
+	e = newEntity(id) | Entity(maxGeneration)
+	e.next()
 	if id != e.ID() {
 		t.Errorf("Expected: %d, Got: %d", id, e.ID())
 	}
-	if uint8(0) != e.Version() {
-		t.Errorf("Expected: %d, Got: %d", uint8(0), e.Version())
+	if Generation(0) != e.Version() {
+		t.Errorf("Expected: %d, Got: %d", Generation(0), e.Version())
 	}
 }
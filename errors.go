@@ -0,0 +1,46 @@
+package ecs
+
+import "errors"
+
+var (
+	// ErrNotInitialized is returned when the store of a component type was not
+	// initialized.
+	ErrNotInitialized = errors.New("ecs: component not initialized")
+
+	// ErrAlreadyInitialized is returned when initializing a component type
+	// which already has a store.
+	ErrAlreadyInitialized = errors.New("ecs: component already initialized")
+
+	// ErrDuplicateID is returned when initializing a component type whose ID
+	// is taken by another type.
+	ErrDuplicateID = errors.New("ecs: component ID taken by another type")
+
+	// ErrComponentLimit is returned when a component ID is beyond the component
+	// limit of the world, or no free ID is left to register.
+	ErrComponentLimit = errors.New("ecs: component limit reached")
+
+	// ErrDuplicateComponent is returned when adding a component which the
+	// entity already has.
+	ErrDuplicateComponent = errors.New("ecs: entity already has component")
+
+	// ErrMissingComponent is returned when the entity does not have the
+	// component.
+	ErrMissingComponent = errors.New("ecs: entity does not have component")
+
+	// ErrEntityLimit is returned when creating an entity while the entity limit
+	// is reached.
+	ErrEntityLimit = errors.New("ecs: entity limit reached")
+
+	// ErrStaleEntity is returned when the entity is dead, including stale
+	// references to an entity whose ID has since been recycled.
+	ErrStaleEntity = errors.New("ecs: entity is dead or stale")
+
+	// ErrSnapshotFormat is returned when restoring data which is not a snapshot,
+	// was written by an unsupported version of the format or a build with a
+	// different entity layout, or does not fit the limits of the world.
+	ErrSnapshotFormat = errors.New("ecs: invalid snapshot format")
+
+	// ErrSnapshotStore is returned when a snapshot contains a component store
+	// which is not initialized with a codec in the restoring world.
+	ErrSnapshotStore = errors.New("ecs: snapshot store not registered")
+)
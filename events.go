@@ -0,0 +1,120 @@
+package ecs
+
+import (
+	"iter"
+
+	"github.com/jdavasligil/go-ecs/pkg/queue"
+)
+
+// Events is a double buffered queue of events of type T.
+//
+// Events sent during an update remain readable until the end of the next
+// update, so every reader which runs once per update sees each event exactly
+// once regardless of the order systems run in.
+type Events[T any] struct {
+	// buffers hold the events of the previous and current update.
+	buffers [2]*queue.RingBuffer[T]
+
+	// ends holds the sequence number following the last event of each buffer.
+	ends [2]uint64
+
+	// current is the index of the buffer receiving events.
+	current int
+}
+
+// EventReader tracks the events already read by a single reader.
+type EventReader struct {
+	// cursor is the sequence number of the next unread event.
+	cursor uint64
+}
+
+// eventQueue is the type-erased view of Events used to update every queue.
+type eventQueue interface {
+	Update()
+}
+
+// NewEvents creates an event queue holding up to capacity events per update.
+// The oldest events are dropped when a buffer is full. A capacity below one is
+// raised to one.
+func NewEvents[T any](capacity int) *Events[T] {
+	capacity = max(capacity, 1)
+	return &Events[T]{
+		buffers: [2]*queue.RingBuffer[T]{
+			queue.NewRingBuffer[T](capacity),
+			queue.NewRingBuffer[T](capacity),
+		},
+	}
+}
+
+// Send pushes an event to the current buffer.
+func (ev *Events[T]) Send(event T) {
+	ev.buffers[ev.current].Push(event)
+	ev.ends[ev.current]++
+}
+
+// Read iterates over every event the reader has not seen yet, oldest first,
+// and advances the reader.
+func (ev *Events[T]) Read(r *EventReader) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, b := range [2]int{1 - ev.current, ev.current} {
+			buf := ev.buffers[b]
+			start := ev.ends[b] - uint64(buf.Len())
+			for seq := max(start, r.cursor); seq < ev.ends[b]; seq++ {
+				r.cursor = seq + 1
+				if !yield(buf.At(int(seq - start))) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Len returns the number of events held by both buffers.
+func (ev *Events[T]) Len() int {
+	return ev.buffers[0].Len() + ev.buffers[1].Len()
+}
+
+// Update drops the events of the previous update and swaps the buffers.
+func (ev *Events[T]) Update() {
+	end := ev.ends[ev.current]
+	ev.current = 1 - ev.current
+	ev.buffers[ev.current].Clear()
+	ev.ends[ev.current] = end
+}
+
+// InitializeEvents creates the event queue for type T on the world.
+//
+// Capacity is the maximum number of events kept per update. See NewEvents.
+func InitializeEvents[T any](w *World, capacity int) bool {
+	key := typeKey[T]()
+	if _, ok := w.events[key]; ok {
+		return false
+	}
+	w.events[key] = NewEvents[T](capacity)
+	return true
+}
+
+// EventsOf returns the event queue for type T.
+//
+// Reference is nil if the events were not initialized.
+func EventsOf[T any](w *World) *Events[T] {
+	ev, _ := w.events[typeKey[T]()].(*Events[T])
+	return ev
+}
+
+// SendEvent pushes an event to the queue for type T if it was initialized.
+func SendEvent[T any](w *World, event T) bool {
+	ev := EventsOf[T](w)
+	if ev == nil {
+		return false
+	}
+	ev.Send(event)
+	return true
+}
+
+// UpdateEvents updates every event queue on the world. Call it once per update.
+func (w *World) UpdateEvents() {
+	for _, ev := range w.events {
+		ev.Update()
+	}
+}
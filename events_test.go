@@ -0,0 +1,100 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+type Collision struct {
+	A, B ecs.Entity
+}
+
+type Destroyed struct {
+	Entity ecs.Entity
+}
+
+func readAll[T any](ev *ecs.Events[T], r *ecs.EventReader) []T {
+	events := make([]T, 0)
+	for e := range ev.Read(r) {
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestEvents(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	testutil.AssertEqual(t, ecs.InitializeEvents[Collision](&world, 8), true)
+	testutil.AssertEqual(t, ecs.InitializeEvents[Collision](&world, 8), false)
+	testutil.AssertEqual(t, ecs.SendEvent(&world, Destroyed{}), false)
+	testutil.AssertEqual(t, ecs.EventsOf[Destroyed](&world) == nil, true)
+	testutil.AssertEqual(t, ecs.InitializeEvents[Destroyed](&world, 0), true)
+	testutil.AssertEqual(t, ecs.SendEvent(&world, Destroyed{}), true)
+
+	collisions := ecs.EventsOf[Collision](&world)
+	var early, late ecs.EventReader
+
+	t.Run("Read", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.SendEvent(&world, Collision{1, 2}), true)
+		testutil.AssertEqual(t, len(readAll(collisions, &early)), 1)
+		collisions.Send(Collision{3, 4})
+
+		events := readAll(collisions, &early)
+		testutil.AssertEqual(t, len(events), 1)
+		testutil.AssertEqual(t, events[0], Collision{3, 4})
+		testutil.AssertEqual(t, len(readAll(collisions, &early)), 0)
+	})
+
+	t.Run("DoubleBuffer", func(t *testing.T) {
+		world.UpdateEvents()
+		collisions.Send(Collision{5, 6})
+
+		events := readAll(collisions, &late)
+		testutil.AssertEqual(t, len(events), 3)
+		testutil.AssertEqual(t, events[0], Collision{1, 2})
+		testutil.AssertEqual(t, events[2], Collision{5, 6})
+
+		world.UpdateEvents()
+		events = readAll(collisions, &early)
+		testutil.AssertEqual(t, len(events), 1)
+		testutil.AssertEqual(t, events[0], Collision{5, 6})
+
+		world.UpdateEvents()
+		testutil.AssertEqual(t, collisions.Len(), 0)
+		testutil.AssertEqual(t, len(readAll(collisions, &ecs.EventReader{})), 0)
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		collisions.Send(Collision{7, 8})
+		collisions.Send(Collision{9, 10})
+		for range collisions.Read(&early) {
+			break
+		}
+		events := readAll(collisions, &early)
+		testutil.AssertEqual(t, len(events), 1)
+		testutil.AssertEqual(t, events[0], Collision{9, 10})
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		ev := ecs.NewEvents[int](2)
+		var r ecs.EventReader
+		for i := 0; i < 5; i++ {
+			ev.Send(i)
+		}
+		events := readAll(ev, &r)
+		testutil.AssertEqual(t, len(events), 2)
+		testutil.AssertEqual(t, events[0], 3)
+
+		ev = ecs.NewEvents[int](0)
+		ev.Send(1)
+		ev.Send(2)
+		events = readAll(ev, &ecs.EventReader{})
+		testutil.AssertEqual(t, len(events), 1)
+		testutil.AssertEqual(t, events[0], 2)
+	})
+}
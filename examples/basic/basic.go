@@ -7,7 +7,8 @@ import (
 )
 
 // Each component needs a unique ID. Creating an enum for all of your
-// components is an easy way to accomplish that.
+// components is an easy way to accomplish that. Types without an ID method,
+// such as those from other packages, can be given one with ecs.Register.
 const (
 	PositionID ecs.ComponentID = iota
 	VelocityID
@@ -25,6 +26,11 @@ type Velocity struct {
 // Tags are components with no data. Useful for filtering queries.
 type Tag struct{}
 
+// Events are plain data too, but do not need an ID.
+type Destroyed struct {
+	Entity ecs.Entity
+}
+
 // Every component must have an ID Method that returns a ComponentID.
 // This is used by the ECS to index the internal component store.
 func (c Position) ID() ecs.ComponentID { return PositionID }
@@ -86,32 +92,64 @@ func main() {
 		fmt.Printf("    Velocity - %v\n", v)
 	}
 
-	// Removal must be done manually for each component.
-	ecs.Remove[Position](&world, entity2)
-	ecs.Remove[Velocity](&world, entity2)
+	// Iterators yield the entity and pointers to each component in a single
+	// pass without looking the components up again.
+	for e, c := range ecs.Each2[Position, Velocity](&world) {
+		c.A.x += c.B.x
+		fmt.Printf("Entity ID: %d\n", e.ID())
+		fmt.Printf("    Position - %v\n", c.A)
+	}
+
+	// Prefabs are templates of component values. Spawn creates an entity with
+	// a copy of each value, and Clone copies every component of an entity.
+	mover := ecs.NewPrefab(
+		ecs.With(Position{}),
+		ecs.With(Velocity{x: 1.0}),
+	)
+	spawned := world.Spawn(mover)
+	cloned := world.Clone(spawned)
+	fmt.Printf("Spawned ID: %d, Cloned ID: %d\n", spawned.ID(), cloned.ID())
+
+	// Components can be removed individually.
+	ecs.Remove[Tag](&world, entity1)
 
 	// Remove and clean performs a reallocation preventing a memory leak.
 	// This only needs to be performed once per component store at the end.
-	ecs.RemoveAndClean[Position](&world, entity1)
-	ecs.RemoveAndClean[Velocity](&world, entity1)
-	ecs.RemoveAndClean[Tag](&world, entity1)
+	ecs.RemoveAndClean[Velocity](&world, entity2)
+
+	// Events let systems alert any subscribers, such as when an entity dies.
+	// Each reader keeps its own cursor into the queue.
+	ecs.InitializeEvents[Destroyed](&world, 64)
+	var reader ecs.EventReader
 
-	// Once the components are removed, its safe to destroy the entities.
+	// Destroying an entity removes every component it still owns.
 	world.DestroyEntity(entity1)
 	world.DestroyEntity(entity2)
+	ecs.SendEvent(&world, Destroyed{entity1})
+	ecs.SendEvent(&world, Destroyed{entity2})
+
+	for event := range ecs.EventsOf[Destroyed](&world).Read(&reader) {
+		fmt.Printf("Destroyed ID: %d\n", event.Entity.ID())
+	}
+
+	// Events are double buffered. They are dropped after two updates.
+	world.UpdateEvents()
 
 	// Note that entity IDs are recycled. We now have dangling references.
 	entity1v2 := world.NewEntity()
 	fmt.Printf("Old ID: %d, Version: %d\n", entity1.ID(), entity1.Version())
 	fmt.Printf("New ID: %d, Version: %d\n", entity1v2.ID(), entity1v2.Version())
 
-	// The version increments when an entity is destroyed. This means you can
-	// validate your entity reference by comparing versions.
+	// The version increments when an entity is destroyed. The world compares
+	// versions to refuse stale references, which IsAlive reports directly.
+	fmt.Printf("Old alive: %t, New alive: %t\n",
+		world.IsAlive(entity1), world.IsAlive(entity1v2))
 	//
 	// This rolls over after 255 generations. Hence, it is still possible to
-	// have an incorrect match, though it is unlikely.
+	// have an incorrect match, though it is unlikely. Build with the ecs64 tag
+	// for 32-bit IDs and versions when IDs are recycled millions of times.
 	//
 	// I would recommend being careful when holding on to entity references
 	// after deletion. An event system could be used to alert any subscribers
-	// that its reference is no longer valid.
+	// that its reference is no longer valid, as with Destroyed above.
 }
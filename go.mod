@@ -1,3 +1,3 @@
 module github.com/jdavasligil/go-ecs
 
-go 1.21.0
+go 1.23.0
@@ -0,0 +1,118 @@
+package ecs
+
+// group keeps the entities which have every component of a set packed at the
+// front of each owned component store. The first size elements of every owned
+// store are aligned, so the group can be iterated as a plain zip over the
+// packed arrays.
+//
+// A store may be owned by at most one group. Membership is maintained on every
+// addition and removal at the cost of a few swaps.
+//
+// Time Complexity:
+//
+//	Add    - O(S) where S is the number of owned stores
+//	Remove - O(S) where S is the number of owned stores
+//	Query  - O(1)
+type group struct {
+	stores []ownedStore
+
+	// size is the number of entities which belong to the group.
+	size int
+}
+
+// ownedStore is implemented by component stores which may be owned by a group.
+type ownedStore interface {
+	Store
+
+	// index returns the position of the entity in the packed arrays, or -1.
+	index(e Entity) int
+
+	// isEnabled reports whether the position is that of an enabled component.
+	isEnabled(idx int) bool
+
+	// swap exchanges two elements of the packed arrays.
+	swap(i, j int)
+
+	owner() *group
+	setOwner(g *group)
+
+	// tagged reports whether the store has TagStorage, which cannot be owned.
+	tagged() bool
+}
+
+// group returns the group owning exactly the given stores, creating it if none
+// of the stores are owned yet. Nil is returned if any store is owned by a
+// different group, a store is repeated, a store has TagStorage, or the world
+// is concurrent.
+func (w *World) group(stores ...ownedStore) *group {
+	if w.concurrent {
+		return nil
+	}
+	if g := stores[0].owner(); g != nil {
+		if len(g.stores) != len(stores) {
+			return nil
+		}
+		for _, s := range stores {
+			if s.owner() != g {
+				return nil
+			}
+		}
+		return g
+	}
+
+	g := &group{stores: make([]ownedStore, 0, len(stores))}
+	for _, s := range stores {
+		if s.owner() != nil || s.tagged() {
+			for _, owned := range g.stores {
+				owned.setOwner(nil)
+			}
+			return nil
+		}
+		s.setOwner(g)
+		g.stores = append(g.stores, s)
+	}
+	g.build()
+	return g
+}
+
+// build packs every entity which belongs to the group at the front of each
+// owned store, driven by the smallest store.
+func (g *group) build() {
+	driver := g.stores[0]
+	for _, s := range g.stores {
+		if s.Len() < driver.Len() {
+			driver = s
+		}
+	}
+	// Elements before i have been visited, so swapping the candidate with the
+	// element at size never skips an entity.
+	es := driver.Entities()
+	for i := 0; i < len(es); i++ {
+		g.added(es[i])
+	}
+}
+
+// added moves the entity into the group if it now has every owned component.
+func (g *group) added(e Entity) {
+	for _, s := range g.stores {
+		if idx := s.index(e); !s.isEnabled(idx) || idx < g.size {
+			return
+		}
+	}
+	for _, s := range g.stores {
+		s.swap(s.index(e), g.size)
+	}
+	g.size++
+}
+
+// removed moves the entity out of the group before one of its owned
+// components is removed.
+func (g *group) removed(e Entity) {
+	if idx := g.stores[0].index(e); idx < 0 || idx >= g.size {
+		return
+	}
+	g.size--
+	for _, s := range g.stores {
+		s.swap(s.index(e), g.size)
+	}
+}
@@ -0,0 +1,100 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestGroup(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+
+	entities := make([]ecs.Entity, 16)
+	for i := range entities {
+		entities[i] = world.NewEntity()
+		ecs.Add(&world, entities[i], Position{float32(i), 0.0, 0.0})
+		if i%2 == 0 {
+			ecs.Add(&world, entities[i], Velocity{float32(i), 0.0, 0.0})
+		}
+	}
+
+	// assertAligned checks every member has both components and that the
+	// slices line up with the entities.
+	assertAligned := func(t *testing.T, es []ecs.Entity, ps []Position, vs []Velocity) {
+		t.Helper()
+		testutil.AssertEqual(t, len(ps), len(es))
+		testutil.AssertEqual(t, len(vs), len(es))
+		for i, e := range es {
+			testutil.AssertEqual(t, ps[i].x, vs[i].x)
+			p, _ := ecs.Get[Position](&world, e)
+			testutil.AssertEqual(t, ps[i], p)
+		}
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		es, ps, vs := ecs.Group2[Position, Velocity](&world)
+		testutil.AssertEqual(t, len(es), 8)
+		assertAligned(t, es, ps, vs)
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		ecs.Add(&world, entities[1], Velocity{1.0, 0.0, 0.0})
+		e := world.NewEntity()
+		ecs.Add(&world, e, Velocity{16.0, 0.0, 0.0})
+		ecs.Add(&world, e, Position{16.0, 0.0, 0.0})
+		es, ps, vs := ecs.Group2[Position, Velocity](&world)
+		testutil.AssertEqual(t, len(es), 10)
+		assertAligned(t, es, ps, vs)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		ecs.Remove[Velocity](&world, entities[0])
+		ecs.RemoveAndClean[Position](&world, entities[2])
+		world.DestroyEntity(entities[4])
+		es, ps, vs := ecs.Group2[Position, Velocity](&world)
+		testutil.AssertEqual(t, len(es), 7)
+		assertAligned(t, es, ps, vs)
+		for _, e := range es {
+			testutil.AssertEqual(t, e != entities[0] && e != entities[2], true)
+		}
+	})
+
+	t.Run("Ownership", func(t *testing.T) {
+		es, _, _ := ecs.Group2[Velocity, Health](&world)
+		testutil.AssertEqual(t, es == nil, true)
+		es, _, _, _ = ecs.Group3[Position, Velocity, Health](&world)
+		testutil.AssertEqual(t, es == nil, true)
+		es, _, _ = ecs.Group2[Health, Health](&world)
+		testutil.AssertEqual(t, es == nil, true)
+		es, _, _ = ecs.Group2[Velocity, Position](&world)
+		testutil.AssertEqual(t, len(es), 7)
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		testutil.AssertEqual(t, len(ecs.Query2[Position, Velocity](&world)), 7)
+		count := 0
+		for _, c := range ecs.Each2[Position, Velocity](&world) {
+			testutil.AssertEqual(t, c.A.x, c.B.x)
+			count++
+		}
+		testutil.AssertEqual(t, count, 7)
+	})
+
+	t.Run("Allocs", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(10, func() {
+			es, ps, vs := ecs.Group2[Position, Velocity](&world)
+			for i := range es {
+				ps[i].x += vs[i].x
+			}
+		})
+		testutil.AssertEqual(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,202 @@
+package ecs
+
+import "iter"
+
+// ChildOf relates an entity to its parent. It is an ordinary component and so
+// can be combined with any query, but should be changed through SetParent and
+// RemoveParent which prevent cycles.
+//
+// ChildOf is registered by InitializeHierarchy. Use ComponentIDOf for its ID.
+type ChildOf struct {
+	Parent Entity
+}
+
+// DeletePolicy decides what happens to the children of a destroyed parent.
+type DeletePolicy uint8
+
+const (
+	// Orphan removes the ChildOf component from each child.
+	Orphan DeletePolicy = iota
+
+	// Cascade destroys every descendant along with the parent.
+	Cascade
+)
+
+// hierarchy indexes the children of every parent. It is kept in sync with the
+// ChildOf store through an observer.
+type hierarchy struct {
+	policy DeletePolicy
+
+	// nodes holds the last known parent and the children of each entity which
+	// is part of the hierarchy.
+	nodes *componentStore[node]
+}
+
+// node is the position of an entity in the hierarchy.
+type node struct {
+	parent   Entity
+	children []Entity
+}
+
+// InitializeHierarchy initializes the ChildOf component along with the index
+// of children used to walk the hierarchy. A concurrent world is refused.
+func InitializeHierarchy(w *World, policy DeletePolicy) bool {
+	if w.hierarchy != nil || w.concurrent {
+		return false
+	}
+	if _, ok := Register[ChildOf](w); !ok {
+		return false
+	}
+	h := &hierarchy{
+		policy: policy,
+		nodes:  newComponentStore[node](),
+	}
+	Observe[ChildOf](w, Observer{
+		OnAdd: func(w *World, e Entity) {
+			c, _ := Get[ChildOf](w, e)
+			h.link(e, c.Parent)
+		},
+		OnRemove: func(w *World, e Entity) {
+			h.unlink(e)
+		},
+		OnSet: func(w *World, e Entity) {
+			c, _ := Get[ChildOf](w, e)
+			h.unlink(e)
+			h.link(e, c.Parent)
+		},
+	})
+	w.hierarchy = h
+	return true
+}
+
+// SetParent makes the child a child of the parent, replacing any previous
+// parent. Dead entities and relations which would form a cycle are refused.
+func SetParent(w *World, child, parent Entity) bool {
+	if w.hierarchy == nil || !w.IsAlive(child) || !w.IsAlive(parent) {
+		return false
+	}
+	for ancestor := range Ancestors(w, parent) {
+		if ancestor == child {
+			return false
+		}
+	}
+	if child == parent {
+		return false
+	}
+	if store, _ := storeOf[ChildOf](w); store.Has(child) {
+		return Set(w, child, ChildOf{parent})
+	}
+	return Add(w, child, ChildOf{parent})
+}
+
+// RemoveParent detaches the child from its parent.
+func RemoveParent(w *World, child Entity) bool {
+	return Remove[ChildOf](w, child)
+}
+
+// Parent returns the parent of an entity.
+func Parent(w *World, e Entity) (Entity, bool) {
+	c, ok := Get[ChildOf](w, e)
+	return c.Parent, ok
+}
+
+// Children returns the children of an entity. The slice must not be modified
+// and is only valid until the hierarchy changes.
+//
+// Time Complexity: O(1)
+func Children(w *World, e Entity) []Entity {
+	if w.hierarchy == nil || !w.IsAlive(e) {
+		return nil
+	}
+	n, _ := w.hierarchy.nodes.GetComponent(e)
+	return n.children
+}
+
+// Ancestors iterates from the parent of an entity up to the root.
+func Ancestors(w *World, e Entity) iter.Seq[Entity] {
+	return func(yield func(Entity) bool) {
+		for {
+			parent, ok := Parent(w, e)
+			if !ok || !yield(parent) {
+				return
+			}
+			e = parent
+		}
+	}
+}
+
+// Descendants iterates depth first over every descendant of an entity.
+//
+// The hierarchy must not be changed while iterating. Use a CommandBuffer.
+func Descendants(w *World, e Entity) iter.Seq[Entity] {
+	return func(yield func(Entity) bool) {
+		stack := append([]Entity(nil), Children(w, e)...)
+		for len(stack) > 0 {
+			child := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(child) {
+				return
+			}
+			stack = append(stack, Children(w, child)...)
+		}
+	}
+}
+
+// destroyed applies the delete policy to the children of an entity which is
+// about to be destroyed and forgets its node.
+func (h *hierarchy) destroyed(w *World, e Entity) {
+	n, ok := h.nodes.GetComponent(e)
+	if !ok {
+		return
+	}
+	children := append([]Entity(nil), n.children...)
+	for _, child := range children {
+		if h.policy == Cascade {
+			w.DestroyEntity(child)
+		} else {
+			RemoveParent(w, child)
+		}
+	}
+}
+
+// link records the child in the node of its parent.
+func (h *hierarchy) link(child, parent Entity) {
+	h.nodes.Add(parent, node{})
+	h.nodes.Add(child, node{})
+	p, _ := h.nodes.GetMutComponent(parent)
+	p.children = append(p.children, child)
+	c, _ := h.nodes.GetMutComponent(child)
+	c.parent = parent
+}
+
+// unlink removes the child from the node of its last known parent.
+func (h *hierarchy) unlink(child Entity) {
+	c, ok := h.nodes.GetMutComponent(child)
+	if !ok || c.parent == 0 {
+		return
+	}
+	parent := c.parent
+	c.parent = 0
+	h.prune(child)
+
+	p, ok := h.nodes.GetMutComponent(parent)
+	if !ok {
+		return
+	}
+	for i, x := range p.children {
+		if x == child {
+			p.children[i] = p.children[len(p.children)-1]
+			p.children = p.children[:len(p.children)-1]
+			break
+		}
+	}
+	h.prune(parent)
+}
+
+// prune forgets the node of an entity without a parent or children.
+func (h *hierarchy) prune(e Entity) {
+	n, ok := h.nodes.GetComponent(e)
+	if ok && n.parent == 0 && len(n.children) == 0 {
+		h.nodes.Remove(e)
+	}
+}
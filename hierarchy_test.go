@@ -0,0 +1,111 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func newHierarchyWorld(policy ecs.DeletePolicy) ecs.World {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 8,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.InitializeHierarchy(&world, policy)
+	return world
+}
+
+func TestHierarchy(t *testing.T) {
+	world := newHierarchyWorld(ecs.Orphan)
+	testutil.AssertEqual(t, ecs.InitializeHierarchy(&world, ecs.Orphan), false)
+
+	root := world.NewEntity()
+	arm := world.NewEntity()
+	hand := world.NewEntity()
+	leg := world.NewEntity()
+	for _, e := range []ecs.Entity{root, arm, hand, leg} {
+		ecs.Add(&world, e, Position{})
+	}
+
+	t.Run("SetParent", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.SetParent(&world, arm, root), true)
+		testutil.AssertEqual(t, ecs.SetParent(&world, hand, arm), true)
+		testutil.AssertEqual(t, ecs.SetParent(&world, leg, arm), true)
+		testutil.AssertEqual(t, ecs.SetParent(&world, leg, root), true)
+
+		testutil.AssertEqual(t, ecs.SetParent(&world, root, hand), false)
+		testutil.AssertEqual(t, ecs.SetParent(&world, root, root), false)
+
+		parent, ok := ecs.Parent(&world, hand)
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, parent, arm)
+		_, ok = ecs.Parent(&world, root)
+		testutil.AssertEqual(t, ok, false)
+
+		testutil.AssertEqual(t, len(ecs.Children(&world, root)), 2)
+		testutil.AssertEqual(t, len(ecs.Children(&world, arm)), 1)
+		testutil.AssertEqual(t, len(ecs.Children(&world, leg)), 0)
+	})
+
+	t.Run("Walk", func(t *testing.T) {
+		ancestors := make([]ecs.Entity, 0)
+		for e := range ecs.Ancestors(&world, hand) {
+			ancestors = append(ancestors, e)
+		}
+		testutil.AssertEqual(t, len(ancestors), 2)
+		testutil.AssertEqual(t, ancestors[0], arm)
+		testutil.AssertEqual(t, ancestors[1], root)
+
+		count := 0
+		for range ecs.Descendants(&world, root) {
+			count++
+		}
+		testutil.AssertEqual(t, count, 3)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		count := 0
+		for _, c := range ecs.Each2[ecs.ChildOf, Position](&world) {
+			testutil.AssertEqual(t, world.IsAlive(c.A.Parent), true)
+			count++
+		}
+		testutil.AssertEqual(t, count, 3)
+	})
+
+	t.Run("Orphan", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.RemoveParent(&world, leg), true)
+		testutil.AssertEqual(t, len(ecs.Children(&world, root)), 1)
+
+		world.DestroyEntity(arm)
+		testutil.AssertEqual(t, world.IsAlive(hand), true)
+		_, ok := ecs.Parent(&world, hand)
+		testutil.AssertEqual(t, ok, false)
+		testutil.AssertEqual(t, len(ecs.Children(&world, root)), 0)
+	})
+}
+
+func TestHierarchyCascade(t *testing.T) {
+	world := newHierarchyWorld(ecs.Cascade)
+
+	root := world.NewEntity()
+	parent := root
+	for i := 0; i < 4; i++ {
+		child := world.NewEntity()
+		ecs.SetParent(&world, child, parent)
+		ecs.SetParent(&world, world.NewEntity(), parent)
+		parent = child
+	}
+	other := world.NewEntity()
+	ecs.SetParent(&world, world.NewEntity(), other)
+	testutil.AssertEqual(t, world.EntityCount(), 11)
+
+	world.DestroyEntity(root)
+	testutil.AssertEqual(t, world.EntityCount(), 2)
+	testutil.AssertEqual(t, len(ecs.Children(&world, other)), 1)
+
+	es, _ := ecs.Query[ecs.ChildOf](&world)
+	testutil.AssertEqual(t, len(es), 1)
+}
@@ -42,12 +42,35 @@ func main() {
 
     fo.WriteString("// Code generated by \"internal/gen/query_gen.go\"; DO NOT EDIT.\n\n")
     fo.WriteString(fmt.Sprintf("package %s\n\n", os.Getenv("GOPACKAGE")))
+    fo.WriteString("import \"iter\"\n\n")
 
     for q := 2; q <= N; q++ {
         for e := 0; e <= min(N, (26 - q)); e++ {
             gen_query(fo, q, e)
         }
     }
+
+    for q := 2; q <= N; q++ {
+        for e := 0; e <= min(N, (26 - q)); e++ {
+            gen_each(fo, q, e)
+        }
+    }
+
+    for q := 1; q < N; q++ {
+        for o := 1; o <= N - q; o++ {
+            gen_optional(fo, q, o)
+        }
+    }
+
+    for q := 2; q <= N; q++ {
+        for e := 0; e <= min(N, (26 - q)); e++ {
+            gen_parallel(fo, q, e)
+        }
+    }
+
+    for q := 2; q <= N; q++ {
+        gen_group(fo, q)
+    }
 }
 
 func gen_query(fo *os.File, q, e int) {
@@ -87,24 +110,20 @@ func gen_query(fo *os.File, q, e int) {
     fo.WriteString(fmt.Sprintf("func %s[\n", queryName))
     fo.WriteString("    // Intersect\n")
     for i := 0; i < q; i++ {
-        fo.WriteString(fmt.Sprintf("    %c Component,\n", typeParams[i]))
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
     } 
     if e > 0 {
         fo.WriteString("    // Exclude\n")
     }
     for i := q; i < paramCount; i++ {
-        fo.WriteString(fmt.Sprintf("    %c Component,\n", typeParams[i]))
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
     } 
     fo.WriteString("](w *World) []Entity {\n")
 
     // BODY
     for i := 0; i < paramCount; i++ {
         p := typeParams[i]
-        fo.WriteString(fmt.Sprintf("    var noop%c %c\n", p, p))
-    } 
-    for i := 0; i < paramCount; i++ {
-        p := typeParams[i]
-        fo.WriteString(fmt.Sprintf("    store%c, ok%c := w.components[noop%c.ID()].(*componentStore[%c])\n",p,p,p,p))
+        fo.WriteString(fmt.Sprintf("    store%c, ok%c := storeOf[%c](w)\n",p,p,p))
     } 
     fo.WriteString("    es := make([]Entity, 0)\n")
     fo.WriteString("    if !(okA")
@@ -113,9 +132,10 @@ func gen_query(fo *os.File, q, e int) {
         fo.WriteString(fmt.Sprintf(" && ok%c", p))
     } 
     fo.WriteString(") {\n        return es\n    }\n")
+    gen_rlock(fo, paramCount, paramCount)
     for i := 0; i < q; i++ {
         p := typeParams[i]
-        fo.WriteString(fmt.Sprintf("    len%c := len(store%c.entityList)\n", p, p))
+        fo.WriteString(fmt.Sprintf("    len%c := len(store%c.enabledEntities())\n", p, p))
     } 
     fo.WriteString("    minLen := min(lenA")
     for i := 1; i < q; i++ {
@@ -129,16 +149,16 @@ func gen_query(fo *os.File, q, e int) {
     for i := 0; i < q; i++ {
         p := typeParams[i]
         fo.WriteString(fmt.Sprintf("    case len%c:\n", p))
-        fo.WriteString(indent(fmt.Sprintf("for _, e := range store%c.entityList {\n", p), 2))
+        fo.WriteString(indent(fmt.Sprintf("for _, e := range store%c.enabledEntities() {\n", p), 2))
         fo.WriteString(indent("if ", 3))
         ifStr := ""
         for j := 0; j < q; j++ {
             if j == i { continue }
-            typeExists :=fmt.Sprintf("(store%c.entityIndices.At(int(e.ID())) >= 0)", typeParams[j])
+            typeExists :=fmt.Sprintf("store%c.isEnabled(store%c.index(e))", typeParams[j], typeParams[j])
             ifStr += fmt.Sprintf("%s && ", typeExists)
         }
         for j := q; j < paramCount; j++ {
-            notTypeExists :=fmt.Sprintf("(store%c.entityIndices.At(int(e.ID())) < 0)", typeParams[j])
+            notTypeExists :=fmt.Sprintf("!store%c.isEnabled(store%c.index(e))", typeParams[j], typeParams[j])
             ifStr += fmt.Sprintf("%s && ", notTypeExists)
         }
         ifStr = ifStr[:len(ifStr)-3] + "{\n" + indent("es = append(es, e)\n", 4)
@@ -151,6 +171,486 @@ func gen_query(fo *os.File, q, e int) {
     fo.WriteString("    return es\n}\n")
 }
 
+func gen_each(fo *os.File, q, e int) {
+    eachName := ""
+    eachComment := ""
+
+    eachName += fmt.Sprintf("Each%d", q)
+
+    if e > 0 {
+        eachName += fmt.Sprintf("Exclude%d", e)
+        eachComment = fmt.Sprintf(
+`// %s iterates over the intersection of the first %d components
+// exluding the following %d components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+`, eachName, q, e)
+    } else {
+        eachComment = fmt.Sprintf(
+`// %s iterates over the intersection of %d components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of a component type)
+`, eachName, q)
+    }
+
+    // COMMENT
+    fo.WriteString(eachComment)
+
+    // HEADER
+    paramCount := q+e
+
+    rowType := "struct {\n"
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        rowType += fmt.Sprintf("    %c *%c\n", p, p)
+    }
+    rowType += "}"
+
+    fo.WriteString(fmt.Sprintf("func %s[\n", eachName))
+    fo.WriteString("    // Intersect\n")
+    for i := 0; i < q; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    if e > 0 {
+        fo.WriteString("    // Exclude\n")
+    }
+    for i := q; i < paramCount; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString(fmt.Sprintf("](w *World) iter.Seq2[Entity, %s] {\n", rowType))
+
+    // The iterator only forwards to the loop so that it may be inlined and
+    // kept on the stack.
+    typeArgs := "["
+    for i := 0; i < paramCount; i++ {
+        if i > 0 {
+            typeArgs += ", "
+        }
+        typeArgs += string(typeParams[i])
+    }
+    typeArgs += "]"
+    loopName := "e" + eachName[1:]
+
+    fo.WriteString(fmt.Sprintf("    return func(yield func(Entity, %s) bool) {\n", rowType))
+    fo.WriteString(indent(fmt.Sprintf("%s%s(w, yield)\n", loopName, typeArgs), 2))
+    fo.WriteString("    }\n}\n\n")
+
+    // LOOP
+    fo.WriteString(fmt.Sprintf("func %s[\n", loopName))
+    for i := 0; i < paramCount; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString(fmt.Sprintf("](w *World, yield func(Entity, %s) bool) {\n", rowType))
+
+    // BODY
+    for i := 0; i < paramCount; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf("    store%c, ok%c := storeOf[%c](w)\n",p,p,p))
+    }
+    fo.WriteString(indent("if !(okA", 1))
+    for i := 1; i < paramCount; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf(" && ok%c", p))
+    }
+    fo.WriteString(") {\n        return\n    }\n")
+    gen_rlock(fo, paramCount, paramCount)
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(indent(fmt.Sprintf("len%c := len(store%c.enabledEntities())\n", p, p), 1))
+    }
+    fo.WriteString(indent("minLen := min(lenA", 1))
+    for i := 1; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf(", len%c", p))
+    }
+    fo.WriteString(")\n")
+
+    // SWITCH
+    fo.WriteString(indent("switch minLen {\n", 1))
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(indent(fmt.Sprintf("case len%c:\n", p), 1))
+        fo.WriteString(indent(fmt.Sprintf("for idx%c, e := range store%c.enabledEntities() {\n", p, p), 2))
+        for j := 0; j < q; j++ {
+            if j == i { continue }
+            r := typeParams[j]
+            fo.WriteString(indent(fmt.Sprintf("idx%c := store%c.index(e)\n", r, r), 3))
+            fo.WriteString(indent(fmt.Sprintf("if !store%c.isEnabled(idx%c) {\n", r, r), 3))
+            fo.WriteString(indent("continue\n", 4))
+            fo.WriteString(indent("}\n", 3))
+        }
+        for j := q; j < paramCount; j++ {
+            r := typeParams[j]
+            fo.WriteString(indent(fmt.Sprintf("if store%c.isEnabled(store%c.index(e)) {\n", r, r), 3))
+            fo.WriteString(indent("continue\n", 4))
+            fo.WriteString(indent("}\n", 3))
+        }
+        fo.WriteString(indent(fmt.Sprintf("if !yield(e, %s{", rowType), 3))
+        for j := 0; j < q; j++ {
+            r := typeParams[j]
+            if j > 0 {
+                fo.WriteString(", ")
+            }
+            fo.WriteString(fmt.Sprintf("&store%c.componentList[idx%c]", r, r))
+        }
+        fo.WriteString("}) {\n")
+        fo.WriteString(indent("return\n", 4))
+        fo.WriteString(indent("}\n", 3))
+        fo.WriteString(indent("}\n", 2))
+    }
+    fo.WriteString(indent("}\n", 1))
+
+    fo.WriteString("}\n\n")
+}
+
+func gen_optional(fo *os.File, q, o int) {
+    eachName := fmt.Sprintf("Each%dOptional%d", q, o)
+
+    // COMMENT
+    fo.WriteString(fmt.Sprintf(
+`// %s iterates over the intersection of the first %d components
+// along with the following %d optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+`, eachName, q, o))
+
+    // HEADER
+    paramCount := q+o
+
+    rowType := "struct {\n"
+    for i := 0; i < paramCount; i++ {
+        p := typeParams[i]
+        rowType += fmt.Sprintf("    %c *%c\n", p, p)
+    }
+    rowType += "}"
+
+    fo.WriteString(fmt.Sprintf("func %s[\n", eachName))
+    fo.WriteString("    // Intersect\n")
+    for i := 0; i < q; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString("    // Optional\n")
+    for i := q; i < paramCount; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString(fmt.Sprintf("](w *World) iter.Seq2[Entity, %s] {\n", rowType))
+
+    typeArgs := "["
+    for i := 0; i < paramCount; i++ {
+        if i > 0 {
+            typeArgs += ", "
+        }
+        typeArgs += string(typeParams[i])
+    }
+    typeArgs += "]"
+    loopName := "e" + eachName[1:]
+
+    fo.WriteString(fmt.Sprintf("    return func(yield func(Entity, %s) bool) {\n", rowType))
+    fo.WriteString(indent(fmt.Sprintf("%s%s(w, yield)\n", loopName, typeArgs), 2))
+    fo.WriteString("    }\n}\n\n")
+
+    // LOOP
+    fo.WriteString(fmt.Sprintf("func %s[\n", loopName))
+    for i := 0; i < paramCount; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString(fmt.Sprintf("](w *World, yield func(Entity, %s) bool) {\n", rowType))
+
+    // BODY
+    for i := 0; i < paramCount; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf("    store%c, ok%c := storeOf[%c](w)\n",p,p,p))
+    }
+    if q == 1 {
+        fo.WriteString(indent("if !okA {\n        return\n    }\n", 1))
+    } else {
+        fo.WriteString(indent("if !(okA", 1))
+        for i := 1; i < q; i++ {
+            p := typeParams[i]
+            fo.WriteString(fmt.Sprintf(" && ok%c", p))
+        }
+        fo.WriteString(") {\n        return\n    }\n")
+    }
+
+    gen_rlock(fo, q, paramCount)
+
+    // A single intersected component drives the loop without a switch.
+    if q == 1 {
+        gen_optional_loop(fo, q, o, 0, rowType, 1)
+        fo.WriteString("}\n\n")
+        return
+    }
+
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(indent(fmt.Sprintf("len%c := len(store%c.enabledEntities())\n", p, p), 1))
+    }
+    fo.WriteString(indent("minLen := min(lenA", 1))
+    for i := 1; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf(", len%c", p))
+    }
+    fo.WriteString(")\n")
+
+    // SWITCH
+    fo.WriteString(indent("switch minLen {\n", 1))
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(indent(fmt.Sprintf("case len%c:\n", p), 1))
+        gen_optional_loop(fo, q, o, i, rowType, 2)
+    }
+    fo.WriteString(indent("}\n", 1))
+
+    fo.WriteString("}\n\n")
+}
+
+// gen_optional_loop writes the loop over the store of the driving component
+// at the given depth of indentation.
+func gen_optional_loop(fo *os.File, q, o, i int, rowType string, d int) {
+    p := typeParams[i]
+    fo.WriteString(indent(fmt.Sprintf("for idx%c, e := range store%c.enabledEntities() {\n", p, p), d))
+    for j := 0; j < q; j++ {
+        if j == i { continue }
+        r := typeParams[j]
+        fo.WriteString(indent(fmt.Sprintf("idx%c := store%c.index(e)\n", r, r), d+1))
+        fo.WriteString(indent(fmt.Sprintf("if !store%c.isEnabled(idx%c) {\n", r, r), d+1))
+        fo.WriteString(indent("continue\n", d+2))
+        fo.WriteString(indent("}\n", d+1))
+    }
+    fo.WriteString(indent(fmt.Sprintf("row := %s{", rowType), d+1))
+    for j := 0; j < q; j++ {
+        r := typeParams[j]
+        if j > 0 {
+            fo.WriteString(", ")
+        }
+        fo.WriteString(fmt.Sprintf("%c: &store%c.componentList[idx%c]", r, r, r))
+    }
+    fo.WriteString("}\n")
+    for j := q; j < q+o; j++ {
+        r := typeParams[j]
+        fo.WriteString(indent(fmt.Sprintf("if ok%c {\n", r), d+1))
+        fo.WriteString(indent(fmt.Sprintf("if idx%c := store%c.index(e); store%c.isEnabled(idx%c) {\n", r, r, r, r), d+2))
+        fo.WriteString(indent(fmt.Sprintf("row.%c = &store%c.componentList[idx%c]\n", r, r, r), d+3))
+        fo.WriteString(indent("}\n", d+2))
+        fo.WriteString(indent("}\n", d+1))
+    }
+    fo.WriteString(indent("if !yield(e, row) {\n", d+1))
+    fo.WriteString(indent("return\n", d+2))
+    fo.WriteString(indent("}\n", d+1))
+    fo.WriteString(indent("}\n", d))
+}
+
+func gen_parallel(fo *os.File, q, e int) {
+    parallelName := fmt.Sprintf("ParallelEach%d", q)
+    parallelComment := ""
+
+    if e > 0 {
+        parallelName += fmt.Sprintf("Exclude%d", e)
+        parallelComment = fmt.Sprintf(
+`// %s calls fn for the intersection of the first %d components
+// exluding the following %d components listed, from a pool of workers.
+`, parallelName, q, e)
+    } else {
+        parallelComment = fmt.Sprintf(
+`// %s calls fn for the intersection of %d components from a pool of
+// workers.
+`, parallelName, q)
+    }
+    parallelComment += `//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+`
+
+    // COMMENT
+    fo.WriteString(parallelComment)
+
+    // HEADER
+    paramCount := q+e
+
+    rowType := "struct {\n"
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        rowType += fmt.Sprintf("    %c *%c\n", p, p)
+    }
+    rowType += "}"
+
+    fo.WriteString(fmt.Sprintf("func %s[\n", parallelName))
+    fo.WriteString("    // Intersect\n")
+    for i := 0; i < q; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    if e > 0 {
+        fo.WriteString("    // Exclude\n")
+    }
+    for i := q; i < paramCount; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString(fmt.Sprintf("](w *World, p Parallel, fn func(Entity, %s)) {\n", rowType))
+
+    // BODY
+    for i := 0; i < paramCount; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf("    store%c, ok%c := storeOf[%c](w)\n",p,p,p))
+    }
+    fo.WriteString(indent("if !(okA", 1))
+    for i := 1; i < paramCount; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf(" && ok%c", p))
+    }
+    fo.WriteString(") {\n        return\n    }\n")
+    gen_rlock(fo, paramCount, paramCount)
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(indent(fmt.Sprintf("len%c := len(store%c.enabledEntities())\n", p, p), 1))
+    }
+    fo.WriteString(indent("minLen := min(lenA", 1))
+    for i := 1; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf(", len%c", p))
+    }
+    fo.WriteString(")\n")
+
+    // SWITCH
+    fo.WriteString(indent("switch minLen {\n", 1))
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(indent(fmt.Sprintf("case len%c:\n", p), 1))
+        fo.WriteString(indent(fmt.Sprintf("entities := store%c.enabledEntities()\n", p), 2))
+        fo.WriteString(indent(fmt.Sprintf("p.run(len%c, func(lo, hi int) {\n", p), 2))
+        fo.WriteString(indent(fmt.Sprintf("for idx%c := lo; idx%c < hi; idx%c++ {\n", p, p, p), 3))
+        fo.WriteString(indent(fmt.Sprintf("e := entities[idx%c]\n", p), 4))
+        for j := 0; j < q; j++ {
+            if j == i { continue }
+            r := typeParams[j]
+            fo.WriteString(indent(fmt.Sprintf("idx%c := store%c.index(e)\n", r, r), 4))
+            fo.WriteString(indent(fmt.Sprintf("if !store%c.isEnabled(idx%c) {\n", r, r), 4))
+            fo.WriteString(indent("continue\n", 5))
+            fo.WriteString(indent("}\n", 4))
+        }
+        for j := q; j < paramCount; j++ {
+            r := typeParams[j]
+            fo.WriteString(indent(fmt.Sprintf("if store%c.isEnabled(store%c.index(e)) {\n", r, r), 4))
+            fo.WriteString(indent("continue\n", 5))
+            fo.WriteString(indent("}\n", 4))
+        }
+        fo.WriteString(indent(fmt.Sprintf("fn(e, %s{", rowType), 4))
+        for j := 0; j < q; j++ {
+            r := typeParams[j]
+            if j > 0 {
+                fo.WriteString(", ")
+            }
+            fo.WriteString(fmt.Sprintf("&store%c.componentList[idx%c]", r, r))
+        }
+        fo.WriteString("})\n")
+        fo.WriteString(indent("}\n", 3))
+        fo.WriteString(indent("})\n", 2))
+    }
+    fo.WriteString(indent("}\n", 1))
+
+    fo.WriteString("}\n\n")
+}
+
+func gen_group(fo *os.File, q int) {
+    groupName := fmt.Sprintf("Group%d", q)
+
+    // COMMENT
+    fo.WriteString(fmt.Sprintf(
+`// %s returns the packed entities and data of every entity which has all %d
+// components. The slices are aligned and so can be iterated together.
+//
+// The first call creates a group which takes ownership of the component stores
+// and keeps its members packed at the front of each store as components are
+// added and removed. A store may only be owned by a single group.
+//
+// Slices are nil in a concurrent world, or if a store is not initialized or
+// owned by a different group.
+//
+// Time Complexity: O(1) once the group is created.
+`, groupName, q))
+
+    // HEADER
+    fo.WriteString(fmt.Sprintf("func %s[\n", groupName))
+    for i := 0; i < q; i++ {
+        fo.WriteString(fmt.Sprintf("    %c any,\n", typeParams[i]))
+    }
+    fo.WriteString("](w *World) ([]Entity")
+    for i := 0; i < q; i++ {
+        fo.WriteString(fmt.Sprintf(", []%c", typeParams[i]))
+    }
+    fo.WriteString(") {\n")
+
+    nils := "nil"
+    for i := 0; i < q; i++ {
+        nils += ", nil"
+    }
+
+    // BODY
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf("    store%c, ok%c := storeOf[%c](w)\n",p,p,p))
+    }
+    fo.WriteString("    if !(okA")
+    for i := 1; i < q; i++ {
+        fo.WriteString(fmt.Sprintf(" && ok%c", typeParams[i]))
+    }
+    fo.WriteString(fmt.Sprintf(") {\n        return %s\n    }\n", nils))
+    fo.WriteString("    g := w.group(storeA")
+    for i := 1; i < q; i++ {
+        fo.WriteString(fmt.Sprintf(", store%c", typeParams[i]))
+    }
+    fo.WriteString(")\n")
+    fo.WriteString(fmt.Sprintf("    if g == nil {\n        return %s\n    }\n", nils))
+    fo.WriteString("    return storeA.entityList[:g.size]")
+    for i := 0; i < q; i++ {
+        p := typeParams[i]
+        fo.WriteString(fmt.Sprintf(", store%c.componentList[:g.size]", p))
+    }
+    fo.WriteString("\n}\n\n")
+}
+
+// gen_rlock writes the read lock of the stores in a concurrent world. Stores
+// from index required onwards may be nil.
+func gen_rlock(fo *os.File, required, paramCount int) {
+    fo.WriteString(indent("if w.concurrent {\n", 1))
+    fo.WriteString(indent("defer rlock(", 2))
+    for i := 0; i < paramCount; i++ {
+        p := typeParams[i]
+        if i > 0 {
+            fo.WriteString(", ")
+        }
+        if i < required {
+            fo.WriteString(fmt.Sprintf("store%c.lock", p))
+        } else {
+            fo.WriteString(fmt.Sprintf("store%c.storeLock()", p))
+        }
+    }
+    fo.WriteString(")()\n")
+    fo.WriteString(indent("}\n", 1))
+}
+
 func indent(s string, n int) string {
     for range n {
         s = "    " + s
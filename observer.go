@@ -0,0 +1,74 @@
+package ecs
+
+// Observer holds callbacks which are notified of changes to a component store.
+// Any callback may be nil.
+//
+// Callbacks run synchronously in the middle of the change. They may modify
+// other component stores, but must not add or remove the observed component.
+type Observer struct {
+	// OnAdd is called after the component is added to the entity.
+	OnAdd func(w *World, e Entity)
+
+	// OnRemove is called before the component is removed from the entity, so
+	// the component can still be read.
+	OnRemove func(w *World, e Entity)
+
+	// OnSet is called after the component is replaced with Set or marked as
+	// changed with MarkChanged.
+	OnSet func(w *World, e Entity)
+
+	// OnMove is called when the component of the entity moves within the
+	// packed arrays, such as during the swap in Remove. The new index is given.
+	OnMove func(w *World, e Entity, index int)
+}
+
+// Observe registers an observer for component T. Multiple observers may be
+// registered and are notified in the order observed. A concurrent world is
+// refused.
+func Observe[T any](w *World, o Observer) bool {
+	store, ok := storeOf[T](w)
+	if !ok || w.concurrent {
+		return false
+	}
+	store.world = w
+	store.observers = append(store.observers, o)
+	return true
+}
+
+// Set replaces the component of an entity, marks it as changed, and notifies
+// observers. Dead or stale entities are refused.
+func Set[T any](w *World, e Entity, c T) bool {
+	return SetE(w, e, c) == nil
+}
+
+// SetE is Set returning ErrNotInitialized, ErrStaleEntity or
+// ErrMissingComponent upon failure.
+func SetE[T any](w *World, e Entity, c T) error {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return ErrNotInitialized
+	}
+	if w.concurrent {
+		return setHeld(w, store, e, c)
+	}
+	if !w.entities.isAlive(e) {
+		return ErrStaleEntity
+	}
+	if !store.set(e, c) {
+		return ErrMissingComponent
+	}
+	return nil
+}
+
+// setHeld is SetE for a concurrent world.
+func setHeld[T any](w *World, store *componentStore[T], e Entity, c T) error {
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		return ErrStaleEntity
+	}
+	if !store.Set(e, c) {
+		return ErrMissingComponent
+	}
+	return nil
+}
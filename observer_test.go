@@ -0,0 +1,98 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestObserver(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+
+	added, removed, set := 0, 0, 0
+	removedHP := 0
+	testutil.AssertEqual(t, ecs.Observe[Health](&world, ecs.Observer{
+		OnAdd: func(w *ecs.World, e ecs.Entity) { added++ },
+		OnRemove: func(w *ecs.World, e ecs.Entity) {
+			hp, _ := ecs.Get[Health](w, e)
+			removedHP += hp.hp
+			removed++
+		},
+		OnSet: func(w *ecs.World, e ecs.Entity) { set++ },
+	}), true)
+	testutil.AssertEqual(t, ecs.Observe[CombatTag](&world, ecs.Observer{}), false)
+
+	// index is an external index kept in sync with the packed Position array.
+	index := make(map[ecs.Entity]int)
+	ecs.Observe[Position](&world, ecs.Observer{
+		OnAdd: func(w *ecs.World, e ecs.Entity) {
+			es, _ := ecs.Query[Position](w)
+			for i, x := range es {
+				if x == e {
+					index[e] = i
+				}
+			}
+		},
+		OnRemove: func(w *ecs.World, e ecs.Entity) { delete(index, e) },
+		OnMove:   func(w *ecs.World, e ecs.Entity, i int) { index[e] = i },
+	})
+
+	assertIndex := func(t *testing.T) {
+		t.Helper()
+		es, _ := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, len(index), len(es))
+		for i, e := range es {
+			testutil.AssertEqual(t, index[e], i)
+		}
+	}
+
+	entities := make([]ecs.Entity, 16)
+	for i := range entities {
+		entities[i] = world.NewEntity()
+		ecs.Add(&world, entities[i], Position{float32(i), 0.0, 0.0})
+		ecs.Add(&world, entities[i], Health{i})
+		if i%3 == 0 {
+			ecs.Add(&world, entities[i], Velocity{})
+		}
+	}
+
+	t.Run("AddRemoveSet", func(t *testing.T) {
+		testutil.AssertEqual(t, added, 16)
+		ecs.Remove[Health](&world, entities[4])
+		world.DestroyEntity(entities[5])
+		testutil.AssertEqual(t, removed, 2)
+		testutil.AssertEqual(t, removedHP, 9)
+
+		testutil.AssertEqual(t, ecs.Set(&world, entities[6], Health{60}), true)
+		testutil.AssertEqual(t, ecs.MarkChanged[Health](&world, entities[7]), true)
+		testutil.AssertEqual(t, ecs.Set(&world, entities[5], Health{50}), false)
+		testutil.AssertEqual(t, set, 2)
+		hp, _ := ecs.Get[Health](&world, entities[6])
+		testutil.AssertEqual(t, hp.hp, 60)
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		assertIndex(t)
+		ecs.Remove[Position](&world, entities[0])
+		ecs.RemoveAndClean[Position](&world, entities[15])
+		assertIndex(t)
+		ecs.Group2[Velocity, Position](&world)
+		assertIndex(t)
+		ecs.Remove[Velocity](&world, entities[9])
+		ecs.Add(&world, entities[1], Velocity{})
+		assertIndex(t)
+
+		// The removed entity moves out of the group before it is removed.
+		ecs.Remove[Position](&world, entities[3])
+		ecs.RemoveAndClean[Position](&world, entities[6])
+		assertIndex(t)
+	})
+}
@@ -0,0 +1,81 @@
+package ecs
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Parallel configures how the parallel queries split the packed component
+// arrays into chunks and spread them across a bounded pool of workers.
+type Parallel struct {
+	// Workers is the maximum number of goroutines. GOMAXPROCS is used if zero.
+	Workers int
+
+	// ChunkSize is the number of entities in each chunk. If zero, a size which
+	// gives each worker several chunks is used so the load stays balanced.
+	ChunkSize int
+}
+
+// ParallelQuery calls fn with chunks of the packed entities and components of
+// type T from a pool of workers. It returns once every chunk is processed.
+//
+// The chunks are aligned and never overlap, so fn may mutate the components
+// it is given. It must not access other components of the same type or make
+// structural changes. Use a CommandBuffer for each worker instead.
+func ParallelQuery[T any](w *World, p Parallel, fn func(es []Entity, cs []T)) {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return
+	}
+	if w.concurrent {
+		store.lock.RLock()
+		defer store.lock.RUnlock()
+	}
+	es := store.enabledEntities()
+	cs := store.componentList[:len(es)]
+	p.run(len(es), func(lo, hi int) {
+		fn(es[lo:hi], cs[lo:hi])
+	})
+}
+
+// run calls fn for every chunk of the range [0, n) on a pool of workers and
+// waits for all of them to finish. Workers take the next chunk as they become
+// free.
+func (p Parallel) run(n int, fn func(lo, hi int)) {
+	workers := p.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	size := p.ChunkSize
+	if size <= 0 {
+		size = max(1, n/(workers*4))
+	}
+	chunks := (n + size - 1) / size
+	workers = min(workers, chunks)
+
+	if workers <= 1 {
+		for lo := 0; lo < n; lo += size {
+			fn(lo, min(lo+size, n))
+		}
+		return
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for {
+				c := int(next.Add(1) - 1)
+				if c >= chunks {
+					return
+				}
+				lo := c * size
+				fn(lo, min(lo+size, n))
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,82 @@
+package ecs_test
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestParallel(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1 << 16,
+		RecycleLimit:   1 << 16,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[DeadTag](&world)
+
+	const count = 10_000
+	for i := 0; i < count; i++ {
+		e := world.NewEntity()
+		ecs.Add(&world, e, Position{})
+		if i%2 == 0 {
+			ecs.Add(&world, e, Velocity{1.0, 2.0, 3.0})
+		}
+		if i%4 == 0 {
+			ecs.Add(&world, e, DeadTag{})
+		}
+	}
+
+	t.Run("ParallelQuery", func(t *testing.T) {
+		var chunks, total atomic.Int64
+		ecs.ParallelQuery(&world, ecs.Parallel{Workers: 4, ChunkSize: 100},
+			func(es []ecs.Entity, ps []Position) {
+				testutil.AssertEqual(t, len(es), len(ps))
+				for i := range ps {
+					ps[i].x += 1.0
+				}
+				chunks.Add(1)
+				total.Add(int64(len(es)))
+			})
+		testutil.AssertEqual(t, chunks.Load(), int64(count/100))
+		testutil.AssertEqual(t, total.Load(), int64(count))
+
+		_, ps := ecs.Query[Position](&world)
+		for _, p := range ps {
+			testutil.AssertEqual(t, p.x, float32(1.0))
+		}
+	})
+
+	t.Run("ParallelEach2", func(t *testing.T) {
+		var total atomic.Int64
+		ecs.ParallelEach2(&world, ecs.Parallel{},
+			func(e ecs.Entity, c struct {
+				A *Position
+				B *Velocity
+			}) {
+				c.A.y += c.B.y
+				total.Add(1)
+			})
+		testutil.AssertEqual(t, total.Load(), int64(count/2))
+
+		for _, c := range ecs.Each2[Position, Velocity](&world) {
+			testutil.AssertEqual(t, c.A.y, float32(2.0))
+		}
+	})
+
+	t.Run("ParallelEach2Exclude1", func(t *testing.T) {
+		var total atomic.Int64
+		ecs.ParallelEach2Exclude1[Position, Velocity, DeadTag](&world,
+			ecs.Parallel{Workers: 1},
+			func(e ecs.Entity, c struct {
+				A *Position
+				B *Velocity
+			}) {
+				total.Add(1)
+			})
+		testutil.AssertEqual(t, total.Load(), int64(count/4))
+	})
+}
@@ -19,11 +19,11 @@ func (b BitsetUint64) GetBit(i int) bool {
 }
 
 func (b BitsetUint64) SetBit(i int) {
-	b[i/64] |= (uint64(1) << i % 64)
+	b[i/64] |= (uint64(1) << (i % 64))
 }
 
 func (b BitsetUint64) ClearBit(i int) {
-	b[i/64] &= ^(uint64(1) << i % 64)
+	b[i/64] &= ^(uint64(1) << (i % 64))
 }
 
 func (b BitsetUint64) Len() int {
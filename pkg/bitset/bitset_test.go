@@ -35,3 +35,21 @@ func TestUint64(t *testing.T) {
 		t.Errorf("Expected zero at position 5. Got one.")
 	}
 }
+
+func TestUint64Words(t *testing.T) {
+	bs := bitset.NewUint64(256)
+	for _, i := range []int{0, 63, 64, 100, 255} {
+		bs.SetBit(i)
+	}
+	for i := 0; i < bs.Len(); i++ {
+		set := i == 0 || i == 63 || i == 64 || i == 100 || i == 255
+		if bs.GetBit(i) != set {
+			t.Errorf("Expected bit %d to be %t.", i, set)
+		}
+	}
+
+	bs.ClearBit(100)
+	if bs.GetBit(100) || !bs.GetBit(64) {
+		t.Errorf("Expected only bit 100 to be cleared.")
+	}
+}
@@ -44,6 +44,19 @@ func (rb *RingBuffer[T]) Peek() T {
 	return rb.buf[(rb.capacity+(rb.back-rb.length))%rb.capacity]
 }
 
+// At returns the element at index i counting from the front of the queue.
+// At assumes 0 <= i < Len.
+func (rb *RingBuffer[T]) At(i int) T {
+	return rb.buf[(rb.capacity+(rb.back-rb.length+i))%rb.capacity]
+}
+
+// Clear empties the queue without releasing its memory.
+func (rb *RingBuffer[T]) Clear() {
+	clear(rb.buf)
+	rb.back = 0
+	rb.length = 0
+}
+
 func (rb *RingBuffer[T]) Len() int {
 	return rb.length
 }
@@ -43,3 +43,24 @@ func TestRingBuffer(t *testing.T) {
 		rb.Pop()
 	}
 }
+
+func TestRingBufferAt(t *testing.T) {
+	maxcap := 4
+	rb := queue.NewRingBuffer[int](maxcap)
+	for i := 1; i <= maxcap+2; i++ {
+		rb.Push(i)
+	}
+	for i := 0; i < rb.Len(); i++ {
+		if rb.At(i) != i+3 {
+			t.Errorf("Expected At(%d): %d. Got %d", i, i+3, rb.At(i))
+		}
+	}
+	rb.Clear()
+	if !rb.IsEmpty() {
+		t.Errorf("Expected empty after Clear. Len: %d\n", rb.Len())
+	}
+	rb.Push(7)
+	if rb.At(0) != 7 {
+		t.Errorf("Expected At(0): 7. Got %d", rb.At(0))
+	}
+}
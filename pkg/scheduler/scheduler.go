@@ -0,0 +1,132 @@
+// Package scheduler runs systems on an ecs.World. Each system declares the
+// component types it reads and writes. Systems which do not conflict run in
+// parallel while conflicting systems run in the order they were added.
+package scheduler
+
+import (
+	"sync"
+
+	"github.com/jdavasligil/go-ecs"
+)
+
+// System is a unit of work run by the Scheduler.
+//
+// A system may read any component listed in Reads or Writes but may only
+// mutate components listed in Writes. Structural changes such as creating
+// entities or adding components must be recorded in the provided command
+// buffer, which is flushed once every system of the stage has finished.
+type System struct {
+	Name   string
+	Reads  []ecs.ComponentID
+	Writes []ecs.ComponentID
+
+	// Exclusive systems never run alongside another system and may make
+	// structural changes to the world directly.
+	Exclusive bool
+
+	Run func(w *ecs.World, cb *ecs.CommandBuffer)
+}
+
+// conflicts reports whether two systems may not run at the same time.
+func (s *System) conflicts(other *System) bool {
+	if s.Exclusive || other.Exclusive {
+		return true
+	}
+	for _, id := range s.Writes {
+		for _, o := range other.Writes {
+			if id == o {
+				return true
+			}
+		}
+		for _, o := range other.Reads {
+			if id == o {
+				return true
+			}
+		}
+	}
+	for _, id := range s.Reads {
+		for _, o := range other.Writes {
+			if id == o {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Scheduler orders systems into stages. Systems within a stage do not conflict
+// and run in parallel goroutines. A system always runs in a later stage than
+// every conflicting system added before it.
+type Scheduler struct {
+	systems []System
+	buffers []*ecs.CommandBuffer
+
+	// levels is the stage of each system. It is aligned with systems.
+	levels []int
+
+	// stages lists the indices of the systems run in each stage.
+	stages [][]int
+}
+
+// New creates an empty scheduler.
+func New() *Scheduler {
+	return &Scheduler{
+		systems: make([]System, 0),
+		buffers: make([]*ecs.CommandBuffer, 0),
+		levels:  make([]int, 0),
+		stages:  make([][]int, 0),
+	}
+}
+
+// Add registers a system and places it in the first stage after every
+// conflicting system added before it.
+func (s *Scheduler) Add(sys System) {
+	stage := 0
+	for i := range s.systems {
+		if sys.conflicts(&s.systems[i]) {
+			stage = max(stage, s.levels[i]+1)
+		}
+	}
+	if stage == len(s.stages) {
+		s.stages = append(s.stages, make([]int, 0))
+	}
+	s.stages[stage] = append(s.stages[stage], len(s.systems))
+	s.systems = append(s.systems, sys)
+	s.levels = append(s.levels, stage)
+	s.buffers = append(s.buffers, ecs.NewCommandBuffer())
+}
+
+// Run runs every system once. Stages run one after another and the command
+// buffers of a stage are flushed in registration order when it completes.
+func (s *Scheduler) Run(w *ecs.World) {
+	var wg sync.WaitGroup
+	for _, stage := range s.stages {
+		if len(stage) == 1 {
+			s.systems[stage[0]].Run(w, s.buffers[stage[0]])
+		} else {
+			for _, i := range stage {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					s.systems[i].Run(w, s.buffers[i])
+				}(i)
+			}
+			wg.Wait()
+		}
+		for _, i := range stage {
+			s.buffers[i].Flush(w)
+		}
+	}
+}
+
+// Stages returns the names of the systems run in each stage.
+func (s *Scheduler) Stages() [][]string {
+	names := make([][]string, len(s.stages))
+	for i, stage := range s.stages {
+		names[i] = make([]string, len(stage))
+		for j, sys := range stage {
+			names[i][j] = s.systems[sys].Name
+		}
+	}
+	return names
+}
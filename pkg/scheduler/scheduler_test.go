@@ -0,0 +1,109 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/scheduler"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+const (
+	PositionID ecs.ComponentID = iota
+	VelocityID
+	HealthID
+)
+
+type Position struct{ x float32 }
+type Velocity struct{ x float32 }
+type Health struct{ hp int }
+
+func (c Position) ID() ecs.ComponentID { return PositionID }
+func (c Velocity) ID() ecs.ComponentID { return VelocityID }
+func (c Health) ID() ecs.ComponentID   { return HealthID }
+
+func TestScheduler(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+
+	for i := 0; i < 64; i++ {
+		e := world.NewEntity()
+		ecs.Add(&world, e, Position{0.0})
+		ecs.Add(&world, e, Velocity{1.0})
+		ecs.Add(&world, e, Health{i})
+	}
+
+	s := scheduler.New()
+	s.Add(scheduler.System{
+		Name:   "Movement",
+		Reads:  []ecs.ComponentID{VelocityID},
+		Writes: []ecs.ComponentID{PositionID},
+		Run: func(w *ecs.World, cb *ecs.CommandBuffer) {
+			for _, c := range ecs.Each2[Position, Velocity](w) {
+				c.A.x += c.B.x
+			}
+		},
+	})
+	s.Add(scheduler.System{
+		Name:   "Damage",
+		Writes: []ecs.ComponentID{HealthID},
+		Run: func(w *ecs.World, cb *ecs.CommandBuffer) {
+			es, hs := ecs.Query[Health](w)
+			for i := range hs {
+				hs[i].hp--
+				if hs[i].hp < 0 {
+					cb.DestroyEntity(es[i])
+				}
+			}
+		},
+	})
+	s.Add(scheduler.System{
+		Name:  "Render",
+		Reads: []ecs.ComponentID{PositionID},
+		Run: func(w *ecs.World, cb *ecs.CommandBuffer) {
+			_, ps := ecs.Query[Position](w)
+			for _, p := range ps {
+				testutil.AssertEqual(t, p.x > 0.0, true)
+			}
+		},
+	})
+	s.Add(scheduler.System{
+		Name:      "Spawn",
+		Exclusive: true,
+		Run: func(w *ecs.World, cb *ecs.CommandBuffer) {
+			ecs.Add(w, w.NewEntity(), Position{1.0})
+		},
+	})
+
+	t.Run("Stages", func(t *testing.T) {
+		stages := s.Stages()
+		testutil.AssertEqual(t, len(stages), 3)
+		testutil.AssertEqual(t, len(stages[0]), 2)
+		testutil.AssertEqual(t, stages[0][0], "Movement")
+		testutil.AssertEqual(t, stages[0][1], "Damage")
+		testutil.AssertEqual(t, stages[1][0], "Render")
+		testutil.AssertEqual(t, stages[2][0], "Spawn")
+	})
+
+	t.Run("Run", func(t *testing.T) {
+		s.Run(&world)
+		testutil.AssertEqual(t, world.EntityCount(), 64)
+		s.Run(&world)
+		testutil.AssertEqual(t, world.EntityCount(), 64)
+
+		_, ps := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, len(ps), 64)
+		es := ecs.Query3[Position, Velocity, Health](&world)
+		testutil.AssertEqual(t, len(es), 62)
+		for _, e := range es {
+			p, _ := ecs.Get[Position](&world, e)
+			testutil.AssertEqual(t, p.x, 2.0)
+		}
+	})
+}
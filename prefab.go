@@ -0,0 +1,71 @@
+package ecs
+
+// Prefab is a template of component values used to spawn entities.
+type Prefab struct {
+	components []PrefabComponent
+}
+
+// PrefabComponent is a single component value of a Prefab. Use With to create
+// one.
+type PrefabComponent interface {
+	add(w *World, e Entity) bool
+}
+
+// prefabValue holds the component value added to each spawned entity.
+type prefabValue[T any] struct {
+	value T
+}
+
+func (p prefabValue[T]) add(w *World, e Entity) bool {
+	return Add(w, e, p.value)
+}
+
+// With wraps a component value for use in a Prefab.
+func With[T any](c T) PrefabComponent {
+	return prefabValue[T]{c}
+}
+
+// NewPrefab creates a template from the given component values.
+func NewPrefab(components ...PrefabComponent) *Prefab {
+	return &Prefab{
+		components: append([]PrefabComponent(nil), components...),
+	}
+}
+
+// Spawn creates a new entity with a shallow copy of every component value of
+// the prefab.
+//
+// The null entity is returned upon failure, such as when a component was not
+// initialized. No entity is left behind in that case.
+func (w *World) Spawn(p *Prefab) Entity {
+	e := w.NewEntity()
+	if e == 0 {
+		return e
+	}
+	for _, c := range p.components {
+		if !c.add(w, e) {
+			w.DestroyEntity(e)
+			return 0
+		}
+	}
+	return e
+}
+
+// Clone creates a new entity with a shallow copy of every component of the
+// entity across all initialized stores. Relations are copied too, so a clone
+// shares the parent of the original but not its children.
+//
+// The null entity is returned upon failure.
+func (w *World) Clone(e Entity) Entity {
+	if !w.entities.IsAlive(e) {
+		return 0
+	}
+	clone := w.NewEntity()
+	if clone == 0 {
+		return clone
+	}
+	for _, id := range w.initialized {
+		w.components[id].Copy(e, clone)
+	}
+	return clone
+}
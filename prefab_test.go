@@ -0,0 +1,68 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestPrefab(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    4,
+		RecycleLimit:   4,
+		ComponentLimit: ecs.MAX_COMPONENTS,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+	ecs.InitializeHierarchy(&world, ecs.Orphan)
+
+	t.Run("Spawn", func(t *testing.T) {
+		goblin := ecs.NewPrefab(
+			ecs.With(Position{1.0, 2.0, 3.0}),
+			ecs.With(Health{10}),
+		)
+		e1 := world.Spawn(goblin)
+		e2 := world.Spawn(goblin)
+		testutil.AssertEqual(t, e1 != e2, true)
+		for _, e := range []ecs.Entity{e1, e2} {
+			hp, ok := ecs.Get[Health](&world, e)
+			testutil.AssertEqual(t, ok, true)
+			testutil.AssertEqual(t, hp.hp, 10)
+			p, _ := ecs.Get[Position](&world, e)
+			testutil.AssertEqual(t, p, Position{1.0, 2.0, 3.0})
+		}
+
+		ghost := ecs.NewPrefab(ecs.With(Health{1}), ecs.With(DeadTag{}))
+		count := world.EntityCount()
+		testutil.AssertEqual(t, world.Spawn(ghost), ecs.Entity(0))
+		testutil.AssertEqual(t, world.EntityCount(), count)
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		es, _ := ecs.Query[Health](&world)
+		original := es[0]
+		ecs.Add(&world, original, Velocity{-1.0, 0.0, 0.0})
+		parent := world.NewEntity()
+		ecs.SetParent(&world, original, parent)
+
+		clone := world.Clone(original)
+		testutil.AssertEqual(t, world.IsAlive(clone), true)
+		v, ok := ecs.Get[Velocity](&world, clone)
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, v, Velocity{-1.0, 0.0, 0.0})
+		p, _ := ecs.Parent(&world, clone)
+		testutil.AssertEqual(t, p, parent)
+		testutil.AssertEqual(t, len(ecs.Children(&world, parent)), 2)
+
+		hp, _ := ecs.GetMut[Health](&world, clone)
+		hp.hp = 5
+		originalHP, _ := ecs.Get[Health](&world, original)
+		testutil.AssertEqual(t, originalHP.hp, 10)
+
+		testutil.AssertEqual(t, world.Clone(original), ecs.Entity(0))
+		world.DestroyEntity(clone)
+		testutil.AssertEqual(t, world.Clone(clone), ecs.Entity(0))
+	})
+}
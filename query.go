@@ -1,24 +1,50 @@
 package ecs
 
-// Get returns a copy of the component for a single entity.
-func Get[T Component](w *World, e Entity) (T, bool) {
+import "slices"
+
+// Get returns a copy of the component for a single entity. Dead or stale
+// entities are refused.
+func Get[T any](w *World, e Entity) (T, bool) {
 	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
+	store, ok := storeOf[T](w)
 	if !ok {
-		return noop, ok
+		return noop, false
+	}
+	if w.concurrent {
+		return getHeld(w, store, e)
+	}
+	if !w.entities.isAlive(e) {
+		return noop, false
+	}
+	return store.getComponent(e)
+}
+
+// getHeld is Get for a concurrent world. The ID cannot be recycled to another
+// entity until the component is read.
+func getHeld[T any](w *World, store *componentStore[T], e Entity) (T, bool) {
+	alive := w.entities.Hold(e)
+	defer w.entities.Release()
+	if !alive {
+		var noop T
+		return noop, false
 	}
 	return store.GetComponent(e)
 }
 
 // GetMut returns a mutable reference to the underlying component for
-// a single entity. Only a single caller may claim ownership at a time.
+// a single entity. Only a single caller may claim ownership at a time. Dead
+// or stale entities are refused.
+//
+// The component is marked as changed for change detection.
+//
+// A concurrent world is refused, as the reference would outlive the lock of
+// the store. Use Set instead.
 //
 // Reference is possibly nil.
-func GetMut[T Component](w *World, e Entity) (*T, bool) {
-	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
-	if !ok {
-		return nil, ok
+func GetMut[T any](w *World, e Entity) (*T, bool) {
+	store, ok := storeOf[T](w)
+	if !ok || w.concurrent || !w.entities.IsAlive(e) {
+		return nil, false
 	}
 	return store.GetMutComponent(e)
 }
@@ -26,16 +52,26 @@ func GetMut[T Component](w *World, e Entity) (*T, bool) {
 // Query returns slices to both the entities and their underlying data.
 //
 // The data is mutable, packed, aligned, and so can be iterated together. Only a
-// single caller may claim mutable ownership at a time.
+// single caller may claim mutable ownership at a time. Writes through the slice
+// are not tracked by change detection. Use MarkChanged to record them.
+// Disabled components are left out. See Disabled.
+//
+// In a concurrent world the slices are copies, so writes must be made with Set.
 //
 // Slices are possibly nil.
-func Query[T Component](w *World) ([]Entity, []T) {
-	var noop T
-	store, ok := w.components[noop.ID()].(*componentStore[T])
+func Query[T any](w *World) ([]Entity, []T) {
+	store, ok := storeOf[T](w)
 	if !ok {
 		return nil, nil
 	}
-	return store.entityList, store.componentList
+	if w.concurrent {
+		store.lock.RLock()
+		defer store.lock.RUnlock()
+		es := store.enabledEntities()
+		return slices.Clone(es), slices.Clone(store.componentList[:len(es)])
+	}
+	es := store.enabledEntities()
+	return es, store.componentList[:len(es)]
 }
 
 // QueryExclude performs a query finding the set difference of component T\V.
@@ -44,17 +80,18 @@ func Query[T Component](w *World) ([]Entity, []T) {
 // component V. Paired with GetMut to mutate data.
 //
 // Time Complexity: O(N) where N = # Entities with T
-func QueryExclude[T Component, V Component](w *World) []Entity {
-	var noopT T
-	var noopV V
-	storeT, okT := w.components[noopT.ID()].(*componentStore[T])
-	storeV, okV := w.components[noopV.ID()].(*componentStore[V])
+func QueryExclude[T any, V any](w *World) []Entity {
+	storeT, okT := storeOf[T](w)
+	storeV, okV := storeOf[V](w)
 	es := make([]Entity, 0)
 	if !(okT && okV) {
 		return es
 	}
-	for _, e := range storeT.entityList {
-		if storeV.entityIndices.At(int(e.ID())) < 0 {
+	if w.concurrent {
+		defer rlock(storeT.lock, storeV.lock)()
+	}
+	for _, e := range storeT.enabledEntities() {
+		if !storeV.isEnabled(storeV.index(e)) {
 			es = append(es, e)
 		}
 	}
@@ -0,0 +1,244 @@
+package ecs
+
+import "iter"
+
+// QueryBuilder describes a query from sets of ComponentIDs chosen at runtime,
+// such as by a scripting layer or a debug console. Results are type-erased.
+//
+// Matching entities have every With component, none of the Without components
+// and at least one of the AnyOf components when any are listed. Optional
+// components are yielded when present. Components which were not initialized
+// are treated as empty stores. Disabled components and entities are treated as
+// missing unless the query includes them.
+//
+// Time Complexity: O(N) where N = min(# Entities of a With component)
+type QueryBuilder struct {
+	with     []ComponentID
+	without  []ComponentID
+	optional []ComponentID
+	anyOf    []ComponentID
+
+	// disabled includes disabled components and entities in the query.
+	disabled bool
+}
+
+// NewQueryBuilder creates an empty query which matches every living entity.
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{}
+}
+
+// With requires matching entities to have every listed component.
+func (q *QueryBuilder) With(ids ...ComponentID) *QueryBuilder {
+	q.with = append(q.with, ids...)
+	return q
+}
+
+// Without requires matching entities to have none of the listed components.
+func (q *QueryBuilder) Without(ids ...ComponentID) *QueryBuilder {
+	q.without = append(q.without, ids...)
+	return q
+}
+
+// Optional yields the listed components of matching entities when present.
+func (q *QueryBuilder) Optional(ids ...ComponentID) *QueryBuilder {
+	q.optional = append(q.optional, ids...)
+	return q
+}
+
+// AnyOf requires matching entities to have at least one listed component.
+func (q *QueryBuilder) AnyOf(ids ...ComponentID) *QueryBuilder {
+	q.anyOf = append(q.anyOf, ids...)
+	return q
+}
+
+// IncludeDisabled treats disabled components and entities like enabled ones.
+func (q *QueryBuilder) IncludeDisabled() *QueryBuilder {
+	q.disabled = true
+	return q
+}
+
+// Entities returns the entities which match the query.
+func (q *QueryBuilder) Entities(w *World) []Entity {
+	es := make([]Entity, 0)
+	for e := range q.Each(w) {
+		es = append(es, e)
+	}
+	return es
+}
+
+// Each iterates over the entities which match the query. Each entity is
+// yielded with a pointer to every With component followed by every Optional
+// component in the order listed. Optional pointers are nil when missing.
+//
+// The row is reused between iterations and must not be retained. Writes
+// through the pointers are not tracked. See MarkChanged.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+func (q *QueryBuilder) Each(w *World) iter.Seq2[Entity, []any] {
+	return func(yield func(Entity, []any) bool) {
+		with := make([]erasedStore, len(q.with))
+		for i, id := range q.with {
+			if with[i] = erasedStoreOf(w, id); with[i] == nil {
+				return
+			}
+		}
+		optional := make([]erasedStore, len(q.optional))
+		for i, id := range q.optional {
+			optional[i] = erasedStoreOf(w, id)
+		}
+		without := initializedStores(w, q.without)
+		anyOf := initializedStores(w, q.anyOf)
+		has := q.has
+		entities := q.entities
+		if len(q.anyOf) > 0 && len(anyOf) == 0 {
+			return
+		}
+		// Without components to drive the query every living entity is visited.
+		// They are collected first, as the entities are locked before stores.
+		var living []Entity
+		if len(with) == 0 && len(anyOf) == 0 {
+			living = w.entities.Collect(q.disabled)
+		}
+		if w.concurrent {
+			locks := make([]*rwLock, 0)
+			for _, stores := range [][]erasedStore{with, optional, without, anyOf} {
+				for _, s := range stores {
+					if s != nil {
+						locks = append(locks, s.storeLock())
+					}
+				}
+			}
+			defer rlock(locks...)()
+		}
+
+		row := make([]any, len(with)+len(optional))
+		visit := func(e Entity) bool {
+			for _, s := range with {
+				if !has(s, e) {
+					return true
+				}
+			}
+			for _, s := range without {
+				if has(s, e) {
+					return true
+				}
+			}
+			if len(anyOf) > 0 && !q.hasAny(anyOf, e) {
+				return true
+			}
+			for i, s := range with {
+				row[i] = s.component(e)
+			}
+			for i, s := range optional {
+				row[len(with)+i] = nil
+				if s != nil && has(s, e) {
+					row[len(with)+i] = s.component(e)
+				}
+			}
+			return yield(e, row)
+		}
+
+		switch {
+		case len(with) > 0:
+			driver := with[0]
+			for _, s := range with {
+				if len(entities(s)) < len(entities(driver)) {
+					driver = s
+				}
+			}
+			for _, e := range entities(driver) {
+				if !visit(e) {
+					return
+				}
+			}
+		case len(anyOf) > 0:
+			// Entities in more than one store are visited from the first.
+			for i, s := range anyOf {
+				for _, e := range entities(s) {
+					if q.hasAny(anyOf[:i], e) {
+						continue
+					}
+					if !visit(e) {
+						return
+					}
+				}
+			}
+		default:
+			for _, e := range living {
+				if !visit(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// erasedStore is the type-erased view of a component store used by queries
+// which hold its lock, so none of its methods lock.
+type erasedStore interface {
+	storeLock() *rwLock
+
+	// index returns the position of the entity in the packed arrays, or -1.
+	index(e Entity) int
+
+	// isEnabled reports whether the position is that of an enabled component.
+	isEnabled(idx int) bool
+
+	// find is index, also finding the disabled components of a tag store.
+	find(e Entity) int
+
+	// component returns a pointer to the component of the entity, or nil.
+	component(e Entity) any
+
+	// entities returns the packed array of registered entities.
+	entities() []Entity
+
+	// enabledEntities returns the entities whose component is enabled.
+	enabledEntities() []Entity
+}
+
+// erasedStoreOf returns the store for the given ID, or nil if the component
+// was not initialized.
+func erasedStoreOf(w *World, id ComponentID) erasedStore {
+	s, _ := w.Store(id).(erasedStore)
+	return s
+}
+
+// initializedStores returns the stores of the listed components which were
+// initialized.
+func initializedStores(w *World, ids []ComponentID) []erasedStore {
+	stores := make([]erasedStore, 0, len(ids))
+	for _, id := range ids {
+		if s := erasedStoreOf(w, id); s != nil {
+			stores = append(stores, s)
+		}
+	}
+	return stores
+}
+
+// has reports whether the store has the entity. The component must be enabled
+// unless the query includes disabled components.
+func (q *QueryBuilder) has(s erasedStore, e Entity) bool {
+	if q.disabled {
+		return s.find(e) >= 0
+	}
+	return s.isEnabled(s.index(e))
+}
+
+// entities returns the entities of the store which the query looks at.
+func (q *QueryBuilder) entities(s erasedStore) []Entity {
+	if q.disabled {
+		return s.entities()
+	}
+	return s.enabledEntities()
+}
+
+// hasAny reports whether any of the stores has the entity.
+func (q *QueryBuilder) hasAny(stores []erasedStore, e Entity) bool {
+	for _, s := range stores {
+		if q.has(s, e) {
+			return true
+		}
+	}
+	return false
+}
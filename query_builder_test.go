@@ -0,0 +1,106 @@
+package ecs_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[CombatTag](&world)
+	ecs.Initialize[DeadTag](&world)
+
+	player := world.NewEntity()
+	npc1 := world.NewEntity()
+	npc2 := world.NewEntity()
+	wall := world.NewEntity()
+
+	ecs.Add(&world, player, Position{0.0, 0.0, -1.0})
+	ecs.Add(&world, player, Velocity{0.0, 0.0, -1.0})
+	ecs.Add(&world, player, Health{1})
+	ecs.Add(&world, player, CombatTag{})
+
+	ecs.Add(&world, npc1, Position{0.0, 0.0, 1.0})
+	ecs.Add(&world, npc1, Health{0})
+	ecs.Add(&world, npc1, DeadTag{})
+	ecs.Add(&world, npc1, CombatTag{})
+
+	ecs.Add(&world, npc2, Position{0.0, 0.0, 2.0})
+	ecs.Add(&world, npc2, Velocity{0.0, 0.0, 2.0})
+	ecs.Add(&world, npc2, Health{3})
+
+	ecs.Add(&world, wall, Position{0.0, 0.0, 4.0})
+
+	sorted := func(es []ecs.Entity) []ecs.Entity {
+		slices.Sort(es)
+		return es
+	}
+
+	t.Run("WithWithout", func(t *testing.T) {
+		es := ecs.NewQueryBuilder().
+			With(HealthID).
+			Without(CombatTagID).
+			Entities(&world)
+		testutil.AssertEqual(t, len(es), 1)
+		testutil.AssertEqual(t, es[0], npc2)
+	})
+
+	t.Run("Optional", func(t *testing.T) {
+		q := ecs.NewQueryBuilder().
+			With(PositionID, VelocityID).
+			Optional(CombatTagID, ScoreID)
+		count := 0
+		for e, row := range q.Each(&world) {
+			p, _ := ecs.Get[Position](&world, e)
+			testutil.AssertEqual(t, *row[0].(*Position), p)
+			testutil.AssertEqual(t, row[1] != nil, true)
+			testutil.AssertEqual(t, row[2] != nil, e == player)
+			testutil.AssertEqual(t, row[3] == nil, true)
+			count++
+		}
+		testutil.AssertEqual(t, count, 2)
+	})
+
+	t.Run("AnyOf", func(t *testing.T) {
+		es := ecs.NewQueryBuilder().
+			AnyOf(CombatTagID, DeadTagID).
+			Entities(&world)
+		testutil.AssertEqual(t, len(es), 2)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), []ecs.Entity{player, npc1}), true)
+
+		es = ecs.NewQueryBuilder().
+			With(PositionID).
+			AnyOf(VelocityID, DeadTagID).
+			Without(CombatTagID).
+			Entities(&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{npc2}), true)
+	})
+
+	t.Run("Everything", func(t *testing.T) {
+		world.DestroyEntity(npc2)
+		es := ecs.NewQueryBuilder().Entities(&world)
+		testutil.AssertEqual(t, slices.Equal(sorted(es), []ecs.Entity{player, npc1, wall}), true)
+
+		es = ecs.NewQueryBuilder().Without(HealthID).Entities(&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{wall}), true)
+	})
+
+	t.Run("NotInitialized", func(t *testing.T) {
+		es := ecs.NewQueryBuilder().With(PositionID, ScoreID).Entities(&world)
+		testutil.AssertEqual(t, len(es), 0)
+		es = ecs.NewQueryBuilder().AnyOf(ScoreID).Entities(&world)
+		testutil.AssertEqual(t, len(es), 0)
+		es = ecs.NewQueryBuilder().With(PositionID).Without(ScoreID).Entities(&world)
+		testutil.AssertEqual(t, len(es), 3)
+	})
+}
@@ -2,6 +2,8 @@
 
 package ecs
 
+import "iter"
+
 // Query2 performs a query for the intersection of 2 components.
 //
 // It returns a packed slice of entities which have all components but not
@@ -10,30 +12,31 @@ package ecs
 // Time Complexity: O(N) where N = min(# Entities of a component type)
 func Query2[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
 	es := make([]Entity, 0)
 	if !(okA && okB) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if storeB.entityIndices.At(int(e.ID())) >= 0 {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if storeA.entityIndices.At(int(e.ID())) >= 0 {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -50,34 +53,34 @@ func Query2[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query2Exclude1[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 	// Exclude
-	C Component,
+	C any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && !storeC.isEnabled(storeC.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && !storeC.isEnabled(storeC.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -94,37 +97,36 @@ func Query2Exclude1[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query2Exclude2[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 	// Exclude
-	C Component,
-	D Component,
+	C any,
+	D any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -141,40 +143,38 @@ func Query2Exclude2[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query2Exclude3[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 	// Exclude
-	C Component,
-	D Component,
-	E Component,
+	C any,
+	D any,
+	E any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -191,43 +191,40 @@ func Query2Exclude3[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query2Exclude4[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 	// Exclude
-	C Component,
-	D Component,
-	E Component,
-	F Component,
+	C any,
+	D any,
+	E any,
+	F any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -244,46 +241,42 @@ func Query2Exclude4[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query2Exclude5[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 	// Exclude
-	C Component,
-	D Component,
-	E Component,
-	F Component,
-	G Component,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -300,49 +293,44 @@ func Query2Exclude5[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query2Exclude6[
 	// Intersect
-	A Component,
-	B Component,
+	A any,
+	B any,
 	// Exclude
-	C Component,
-	D Component,
-	E Component,
-	F Component,
-	G Component,
-	H Component,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
 	minLen := min(lenA, lenB)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) < 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && !storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -358,40 +346,40 @@ func Query2Exclude6[
 // Time Complexity: O(N) where N = min(# Entities of a component type)
 func Query3[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -408,44 +396,43 @@ func Query3[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query3Exclude1[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 	// Exclude
-	D Component,
+	D any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && !storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -462,47 +449,45 @@ func Query3Exclude1[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query3Exclude2[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 	// Exclude
-	D Component,
-	E Component,
+	D any,
+	E any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -519,50 +504,47 @@ func Query3Exclude2[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query3Exclude3[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 	// Exclude
-	D Component,
-	E Component,
-	F Component,
+	D any,
+	E any,
+	F any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -579,53 +561,49 @@ func Query3Exclude3[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query3Exclude4[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 	// Exclude
-	D Component,
-	E Component,
-	F Component,
-	G Component,
+	D any,
+	E any,
+	F any,
+	G any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -642,56 +620,51 @@ func Query3Exclude4[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query3Exclude5[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 	// Exclude
-	D Component,
-	E Component,
-	F Component,
-	G Component,
-	H Component,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -708,59 +681,53 @@ func Query3Exclude5[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query3Exclude6[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
+	A any,
+	B any,
+	C any,
 	// Exclude
-	D Component,
-	E Component,
-	F Component,
-	G Component,
-	H Component,
-	I Component,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
 	minLen := min(lenA, lenB, lenC)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) < 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && !storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -776,50 +743,49 @@ func Query3Exclude6[
 // Time Complexity: O(N) where N = min(# Entities of a component type)
 func Query4[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
+	A any,
+	B any,
+	C any,
+	D any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -836,54 +802,52 @@ func Query4[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query4Exclude1[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
+	A any,
+	B any,
+	C any,
+	D any,
 	// Exclude
-	E Component,
+	E any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -900,57 +864,54 @@ func Query4Exclude1[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query4Exclude2[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
+	A any,
+	B any,
+	C any,
+	D any,
 	// Exclude
-	E Component,
-	F Component,
+	E any,
+	F any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -967,60 +928,56 @@ func Query4Exclude2[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query4Exclude3[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
+	A any,
+	B any,
+	C any,
+	D any,
 	// Exclude
-	E Component,
-	F Component,
-	G Component,
+	E any,
+	F any,
+	G any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1037,63 +994,58 @@ func Query4Exclude3[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query4Exclude4[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
+	A any,
+	B any,
+	C any,
+	D any,
 	// Exclude
-	E Component,
-	F Component,
-	G Component,
-	H Component,
+	E any,
+	F any,
+	G any,
+	H any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1110,66 +1062,60 @@ func Query4Exclude4[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query4Exclude5[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
+	A any,
+	B any,
+	C any,
+	D any,
 	// Exclude
-	E Component,
-	F Component,
-	G Component,
-	H Component,
-	I Component,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1186,69 +1132,62 @@ func Query4Exclude5[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query4Exclude6[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	// Exclude
-	E Component,
-	F Component,
-	G Component,
-	H Component,
-	I Component,
-	J Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	var noopJ J
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
-	storeJ, okJ := w.components[noopJ.ID()].(*componentStore[J])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) < 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && !storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1264,60 +1203,58 @@ func Query4Exclude6[
 // Time Complexity: O(N) where N = min(# Entities of a component type)
 func Query5[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1334,64 +1271,61 @@ func Query5[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query5Exclude1[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
 	// Exclude
-	F Component,
+	F any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1408,67 +1342,63 @@ func Query5Exclude1[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query5Exclude2[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
 	// Exclude
-	F Component,
-	G Component,
+	F any,
+	G any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1485,70 +1415,65 @@ func Query5Exclude2[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query5Exclude3[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
 	// Exclude
-	F Component,
-	G Component,
-	H Component,
+	F any,
+	G any,
+	H any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1565,73 +1490,67 @@ func Query5Exclude3[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query5Exclude4[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
 	// Exclude
-	F Component,
-	G Component,
-	H Component,
-	I Component,
+	F any,
+	G any,
+	H any,
+	I any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1648,76 +1567,69 @@ func Query5Exclude4[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query5Exclude5[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	// Exclude
-	F Component,
-	G Component,
-	H Component,
-	I Component,
-	J Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	var noopJ J
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
-	storeJ, okJ := w.components[noopJ.ID()].(*componentStore[J])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1734,79 +1646,71 @@ func Query5Exclude5[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query5Exclude6[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	// Exclude
-	F Component,
-	G Component,
-	H Component,
-	I Component,
-	J Component,
-	K Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	var noopJ J
-	var noopK K
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
-	storeJ, okJ := w.components[noopJ.ID()].(*componentStore[J])
-	storeK, okK := w.components[noopK.ID()].(*componentStore[K])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) < 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && !storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1822,70 +1726,67 @@ func Query5Exclude6[
 // Time Complexity: O(N) where N = min(# Entities of a component type)
 func Query6[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1902,74 +1803,70 @@ func Query6[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query6Exclude1[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
 	// Exclude
-	G Component,
+	G any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeG.isEnabled(storeG.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -1986,77 +1883,72 @@ func Query6Exclude1[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query6Exclude2[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
 	// Exclude
-	G Component,
-	H Component,
+	G any,
+	H any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -2073,80 +1965,74 @@ func Query6Exclude2[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query6Exclude3[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
 	// Exclude
-	G Component,
-	H Component,
-	I Component,
+	G any,
+	H any,
+	I any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -2163,83 +2049,76 @@ func Query6Exclude3[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query6Exclude4[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
-	// Exclude
-	G Component,
-	H Component,
-	I Component,
-	J Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	var noopJ J
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
-	storeJ, okJ := w.components[noopJ.ID()].(*componentStore[J])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -2256,86 +2135,78 @@ func Query6Exclude4[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query6Exclude5[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
-	// Exclude
-	G Component,
-	H Component,
-	I Component,
-	J Component,
-	K Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	var noopJ J
-	var noopK K
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
-	storeJ, okJ := w.components[noopJ.ID()].(*componentStore[J])
-	storeK, okK := w.components[noopK.ID()].(*componentStore[K])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) {
 				es = append(es, e)
 			}
 		}
@@ -2352,92 +2223,16438 @@ func Query6Exclude5[
 // Time Complexity: O(N) where N = min(# Entities of intersected components)
 func Query6Exclude6[
 	// Intersect
-	A Component,
-	B Component,
-	C Component,
-	D Component,
-	E Component,
-	F Component,
-	// Exclude
-	G Component,
-	H Component,
-	I Component,
-	J Component,
-	K Component,
-	L Component,
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+	L any,
 ](w *World) []Entity {
-	var noopA A
-	var noopB B
-	var noopC C
-	var noopD D
-	var noopE E
-	var noopF F
-	var noopG G
-	var noopH H
-	var noopI I
-	var noopJ J
-	var noopK K
-	var noopL L
-	storeA, okA := w.components[noopA.ID()].(*componentStore[A])
-	storeB, okB := w.components[noopB.ID()].(*componentStore[B])
-	storeC, okC := w.components[noopC.ID()].(*componentStore[C])
-	storeD, okD := w.components[noopD.ID()].(*componentStore[D])
-	storeE, okE := w.components[noopE.ID()].(*componentStore[E])
-	storeF, okF := w.components[noopF.ID()].(*componentStore[F])
-	storeG, okG := w.components[noopG.ID()].(*componentStore[G])
-	storeH, okH := w.components[noopH.ID()].(*componentStore[H])
-	storeI, okI := w.components[noopI.ID()].(*componentStore[I])
-	storeJ, okJ := w.components[noopJ.ID()].(*componentStore[J])
-	storeK, okK := w.components[noopK.ID()].(*componentStore[K])
-	storeL, okL := w.components[noopL.ID()].(*componentStore[L])
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	storeL, okL := storeOf[L](w)
 	es := make([]Entity, 0)
 	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK && okL) {
 		return es
 	}
-	lenA := len(storeA.entityList)
-	lenB := len(storeB.entityList)
-	lenC := len(storeC.entityList)
-	lenD := len(storeD.entityList)
-	lenE := len(storeE.entityList)
-	lenF := len(storeF.entityList)
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock, storeL.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
 	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
 	switch minLen {
 	case lenA:
-		for _, e := range storeA.entityList {
-			if (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) && (storeL.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeA.enabledEntities() {
+			if storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) && !storeL.isEnabled(storeL.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenB:
-		for _, e := range storeB.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) && (storeL.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeB.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) && !storeL.isEnabled(storeL.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenC:
-		for _, e := range storeC.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) && (storeL.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeC.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) && !storeL.isEnabled(storeL.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenD:
-		for _, e := range storeD.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) && (storeL.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeD.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeE.isEnabled(storeE.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) && !storeL.isEnabled(storeL.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenE:
-		for _, e := range storeE.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeF.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) && (storeL.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeE.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeF.isEnabled(storeF.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) && !storeL.isEnabled(storeL.index(e)) {
 				es = append(es, e)
 			}
 		}
 	case lenF:
-		for _, e := range storeF.entityList {
-			if (storeA.entityIndices.At(int(e.ID())) >= 0) && (storeB.entityIndices.At(int(e.ID())) >= 0) && (storeC.entityIndices.At(int(e.ID())) >= 0) && (storeD.entityIndices.At(int(e.ID())) >= 0) && (storeE.entityIndices.At(int(e.ID())) >= 0) && (storeG.entityIndices.At(int(e.ID())) < 0) && (storeH.entityIndices.At(int(e.ID())) < 0) && (storeI.entityIndices.At(int(e.ID())) < 0) && (storeJ.entityIndices.At(int(e.ID())) < 0) && (storeK.entityIndices.At(int(e.ID())) < 0) && (storeL.entityIndices.At(int(e.ID())) < 0) {
+		for _, e := range storeF.enabledEntities() {
+			if storeA.isEnabled(storeA.index(e)) && storeB.isEnabled(storeB.index(e)) && storeC.isEnabled(storeC.index(e)) && storeD.isEnabled(storeD.index(e)) && storeE.isEnabled(storeE.index(e)) && !storeG.isEnabled(storeG.index(e)) && !storeH.isEnabled(storeH.index(e)) && !storeI.isEnabled(storeI.index(e)) && !storeJ.isEnabled(storeJ.index(e)) && !storeK.isEnabled(storeK.index(e)) && !storeL.isEnabled(storeL.index(e)) {
 				es = append(es, e)
 			}
 		}
 	}
 	return es
 }
+
+// Each2 iterates over the intersection of 2 components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of a component type)
+func Each2[
+	// Intersect
+	A any,
+	B any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2[A, B](w, yield)
+	}
+}
+
+func each2[
+	A any,
+	B any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	if !(okA && okB) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Exclude1 iterates over the intersection of the first 2 components
+// exluding the following 1 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Exclude1[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2Exclude1[A, B, C](w, yield)
+	}
+}
+
+func each2Exclude1[
+	A any,
+	B any,
+	C any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Exclude2 iterates over the intersection of the first 2 components
+// exluding the following 2 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Exclude2[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2Exclude2[A, B, C, D](w, yield)
+	}
+}
+
+func each2Exclude2[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Exclude3 iterates over the intersection of the first 2 components
+// exluding the following 3 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Exclude3[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2Exclude3[A, B, C, D, E](w, yield)
+	}
+}
+
+func each2Exclude3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Exclude4 iterates over the intersection of the first 2 components
+// exluding the following 4 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Exclude4[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2Exclude4[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each2Exclude4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Exclude5 iterates over the intersection of the first 2 components
+// exluding the following 5 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Exclude5[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2Exclude5[A, B, C, D, E, F, G](w, yield)
+	}
+}
+
+func each2Exclude5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Exclude6 iterates over the intersection of the first 2 components
+// exluding the following 6 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Exclude6[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each2Exclude6[A, B, C, D, E, F, G, H](w, yield)
+	}
+}
+
+func each2Exclude6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			if storeC.isEnabled(storeC.index(e)) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3 iterates over the intersection of 3 components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of a component type)
+func Each3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3[A, B, C](w, yield)
+	}
+}
+
+func each3[
+	A any,
+	B any,
+	C any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Exclude1 iterates over the intersection of the first 3 components
+// exluding the following 1 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3Exclude1[A, B, C, D](w, yield)
+	}
+}
+
+func each3Exclude1[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Exclude2 iterates over the intersection of the first 3 components
+// exluding the following 2 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3Exclude2[A, B, C, D, E](w, yield)
+	}
+}
+
+func each3Exclude2[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Exclude3 iterates over the intersection of the first 3 components
+// exluding the following 3 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3Exclude3[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each3Exclude3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Exclude4 iterates over the intersection of the first 3 components
+// exluding the following 4 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3Exclude4[A, B, C, D, E, F, G](w, yield)
+	}
+}
+
+func each3Exclude4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Exclude5 iterates over the intersection of the first 3 components
+// exluding the following 5 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3Exclude5[A, B, C, D, E, F, G, H](w, yield)
+	}
+}
+
+func each3Exclude5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Exclude6 iterates over the intersection of the first 3 components
+// exluding the following 6 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each3Exclude6[A, B, C, D, E, F, G, H, I](w, yield)
+	}
+}
+
+func each3Exclude6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			if storeD.isEnabled(storeD.index(e)) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4 iterates over the intersection of 4 components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of a component type)
+func Each4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4[A, B, C, D](w, yield)
+	}
+}
+
+func each4[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Exclude1 iterates over the intersection of the first 4 components
+// exluding the following 1 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4Exclude1[A, B, C, D, E](w, yield)
+	}
+}
+
+func each4Exclude1[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Exclude2 iterates over the intersection of the first 4 components
+// exluding the following 2 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4Exclude2[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each4Exclude2[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Exclude3 iterates over the intersection of the first 4 components
+// exluding the following 3 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4Exclude3[A, B, C, D, E, F, G](w, yield)
+	}
+}
+
+func each4Exclude3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Exclude4 iterates over the intersection of the first 4 components
+// exluding the following 4 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4Exclude4[A, B, C, D, E, F, G, H](w, yield)
+	}
+}
+
+func each4Exclude4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Exclude5 iterates over the intersection of the first 4 components
+// exluding the following 5 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4Exclude5[A, B, C, D, E, F, G, H, I](w, yield)
+	}
+}
+
+func each4Exclude5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Exclude6 iterates over the intersection of the first 4 components
+// exluding the following 6 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each4Exclude6[A, B, C, D, E, F, G, H, I, J](w, yield)
+	}
+}
+
+func each4Exclude6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			if storeE.isEnabled(storeE.index(e)) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5 iterates over the intersection of 5 components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of a component type)
+func Each5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5[A, B, C, D, E](w, yield)
+	}
+}
+
+func each5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Exclude1 iterates over the intersection of the first 5 components
+// exluding the following 1 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5Exclude1[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each5Exclude1[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Exclude2 iterates over the intersection of the first 5 components
+// exluding the following 2 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5Exclude2[A, B, C, D, E, F, G](w, yield)
+	}
+}
+
+func each5Exclude2[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Exclude3 iterates over the intersection of the first 5 components
+// exluding the following 3 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5Exclude3[A, B, C, D, E, F, G, H](w, yield)
+	}
+}
+
+func each5Exclude3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Exclude4 iterates over the intersection of the first 5 components
+// exluding the following 4 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5Exclude4[A, B, C, D, E, F, G, H, I](w, yield)
+	}
+}
+
+func each5Exclude4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Exclude5 iterates over the intersection of the first 5 components
+// exluding the following 5 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5Exclude5[A, B, C, D, E, F, G, H, I, J](w, yield)
+	}
+}
+
+func each5Exclude5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Exclude6 iterates over the intersection of the first 5 components
+// exluding the following 6 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each5Exclude6[A, B, C, D, E, F, G, H, I, J, K](w, yield)
+	}
+}
+
+func each5Exclude6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			if storeF.isEnabled(storeF.index(e)) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6 iterates over the intersection of 6 components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of a component type)
+func Each6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6Exclude1 iterates over the intersection of the first 6 components
+// exluding the following 1 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each6Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6Exclude1[A, B, C, D, E, F, G](w, yield)
+	}
+}
+
+func each6Exclude1[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6Exclude2 iterates over the intersection of the first 6 components
+// exluding the following 2 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each6Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6Exclude2[A, B, C, D, E, F, G, H](w, yield)
+	}
+}
+
+func each6Exclude2[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6Exclude3 iterates over the intersection of the first 6 components
+// exluding the following 3 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each6Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6Exclude3[A, B, C, D, E, F, G, H, I](w, yield)
+	}
+}
+
+func each6Exclude3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6Exclude4 iterates over the intersection of the first 6 components
+// exluding the following 4 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each6Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6Exclude4[A, B, C, D, E, F, G, H, I, J](w, yield)
+	}
+}
+
+func each6Exclude4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6Exclude5 iterates over the intersection of the first 6 components
+// exluding the following 5 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each6Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6Exclude5[A, B, C, D, E, F, G, H, I, J, K](w, yield)
+	}
+}
+
+func each6Exclude5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each6Exclude6 iterates over the intersection of the first 6 components
+// exluding the following 6 components listed.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Only a single caller may claim mutable ownership at a time.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each6Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+	L any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each6Exclude6[A, B, C, D, E, F, G, H, I, J, K, L](w, yield)
+	}
+}
+
+func each6Exclude6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+	L any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	storeL, okL := storeOf[L](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK && okL) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock, storeL.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if storeL.isEnabled(storeL.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if storeL.isEnabled(storeL.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if storeL.isEnabled(storeL.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if storeL.isEnabled(storeL.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxF := storeF.index(e)
+			if !storeF.isEnabled(idxF) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if storeL.isEnabled(storeL.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	case lenF:
+		for idxF, e := range storeF.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			if storeG.isEnabled(storeG.index(e)) {
+				continue
+			}
+			if storeH.isEnabled(storeH.index(e)) {
+				continue
+			}
+			if storeI.isEnabled(storeI.index(e)) {
+				continue
+			}
+			if storeJ.isEnabled(storeJ.index(e)) {
+				continue
+			}
+			if storeK.isEnabled(storeK.index(e)) {
+				continue
+			}
+			if storeL.isEnabled(storeL.index(e)) {
+				continue
+			}
+			if !yield(e, struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]}) {
+				return
+			}
+		}
+	}
+}
+
+// Each1Optional1 iterates over the intersection of the first 1 components
+// along with the following 1 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each1Optional1[
+	// Intersect
+	A any,
+	// Optional
+	B any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+	}) bool) {
+		each1Optional1[A, B](w, yield)
+	}
+}
+
+func each1Optional1[
+	A any,
+	B any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	if !okA {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.storeLock())()
+	}
+	for idxA, e := range storeA.enabledEntities() {
+		row := struct {
+			A *A
+			B *B
+		}{A: &storeA.componentList[idxA]}
+		if okB {
+			if idxB := storeB.index(e); storeB.isEnabled(idxB) {
+				row.B = &storeB.componentList[idxB]
+			}
+		}
+		if !yield(e, row) {
+			return
+		}
+	}
+}
+
+// Each1Optional2 iterates over the intersection of the first 1 components
+// along with the following 2 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each1Optional2[
+	// Intersect
+	A any,
+	// Optional
+	B any,
+	C any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each1Optional2[A, B, C](w, yield)
+	}
+}
+
+func each1Optional2[
+	A any,
+	B any,
+	C any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !okA {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.storeLock(), storeC.storeLock())()
+	}
+	for idxA, e := range storeA.enabledEntities() {
+		row := struct {
+			A *A
+			B *B
+			C *C
+		}{A: &storeA.componentList[idxA]}
+		if okB {
+			if idxB := storeB.index(e); storeB.isEnabled(idxB) {
+				row.B = &storeB.componentList[idxB]
+			}
+		}
+		if okC {
+			if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+				row.C = &storeC.componentList[idxC]
+			}
+		}
+		if !yield(e, row) {
+			return
+		}
+	}
+}
+
+// Each1Optional3 iterates over the intersection of the first 1 components
+// along with the following 3 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each1Optional3[
+	// Intersect
+	A any,
+	// Optional
+	B any,
+	C any,
+	D any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each1Optional3[A, B, C, D](w, yield)
+	}
+}
+
+func each1Optional3[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !okA {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.storeLock(), storeC.storeLock(), storeD.storeLock())()
+	}
+	for idxA, e := range storeA.enabledEntities() {
+		row := struct {
+			A *A
+			B *B
+			C *C
+			D *D
+		}{A: &storeA.componentList[idxA]}
+		if okB {
+			if idxB := storeB.index(e); storeB.isEnabled(idxB) {
+				row.B = &storeB.componentList[idxB]
+			}
+		}
+		if okC {
+			if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+				row.C = &storeC.componentList[idxC]
+			}
+		}
+		if okD {
+			if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+				row.D = &storeD.componentList[idxD]
+			}
+		}
+		if !yield(e, row) {
+			return
+		}
+	}
+}
+
+// Each1Optional4 iterates over the intersection of the first 1 components
+// along with the following 4 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each1Optional4[
+	// Intersect
+	A any,
+	// Optional
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each1Optional4[A, B, C, D, E](w, yield)
+	}
+}
+
+func each1Optional4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !okA {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.storeLock(), storeC.storeLock(), storeD.storeLock(), storeE.storeLock())()
+	}
+	for idxA, e := range storeA.enabledEntities() {
+		row := struct {
+			A *A
+			B *B
+			C *C
+			D *D
+			E *E
+		}{A: &storeA.componentList[idxA]}
+		if okB {
+			if idxB := storeB.index(e); storeB.isEnabled(idxB) {
+				row.B = &storeB.componentList[idxB]
+			}
+		}
+		if okC {
+			if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+				row.C = &storeC.componentList[idxC]
+			}
+		}
+		if okD {
+			if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+				row.D = &storeD.componentList[idxD]
+			}
+		}
+		if okE {
+			if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+				row.E = &storeE.componentList[idxE]
+			}
+		}
+		if !yield(e, row) {
+			return
+		}
+	}
+}
+
+// Each1Optional5 iterates over the intersection of the first 1 components
+// along with the following 5 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each1Optional5[
+	// Intersect
+	A any,
+	// Optional
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each1Optional5[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each1Optional5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !okA {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.storeLock(), storeC.storeLock(), storeD.storeLock(), storeE.storeLock(), storeF.storeLock())()
+	}
+	for idxA, e := range storeA.enabledEntities() {
+		row := struct {
+			A *A
+			B *B
+			C *C
+			D *D
+			E *E
+			F *F
+		}{A: &storeA.componentList[idxA]}
+		if okB {
+			if idxB := storeB.index(e); storeB.isEnabled(idxB) {
+				row.B = &storeB.componentList[idxB]
+			}
+		}
+		if okC {
+			if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+				row.C = &storeC.componentList[idxC]
+			}
+		}
+		if okD {
+			if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+				row.D = &storeD.componentList[idxD]
+			}
+		}
+		if okE {
+			if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+				row.E = &storeE.componentList[idxE]
+			}
+		}
+		if okF {
+			if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+				row.F = &storeF.componentList[idxF]
+			}
+		}
+		if !yield(e, row) {
+			return
+		}
+	}
+}
+
+// Each2Optional1 iterates over the intersection of the first 2 components
+// along with the following 1 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Optional1[
+	// Intersect
+	A any,
+	B any,
+	// Optional
+	C any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+	}) bool) {
+		each2Optional1[A, B, C](w, yield)
+	}
+}
+
+func each2Optional1[
+	A any,
+	B any,
+	C any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !(okA && okB) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Optional2 iterates over the intersection of the first 2 components
+// along with the following 2 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Optional2[
+	// Intersect
+	A any,
+	B any,
+	// Optional
+	C any,
+	D any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each2Optional2[A, B, C, D](w, yield)
+	}
+}
+
+func each2Optional2[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.storeLock(), storeD.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Optional3 iterates over the intersection of the first 2 components
+// along with the following 3 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Optional3[
+	// Intersect
+	A any,
+	B any,
+	// Optional
+	C any,
+	D any,
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each2Optional3[A, B, C, D, E](w, yield)
+	}
+}
+
+func each2Optional3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.storeLock(), storeD.storeLock(), storeE.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each2Optional4 iterates over the intersection of the first 2 components
+// along with the following 4 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each2Optional4[
+	// Intersect
+	A any,
+	B any,
+	// Optional
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each2Optional4[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each2Optional4[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.storeLock(), storeD.storeLock(), storeE.storeLock(), storeF.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB]}
+			if okC {
+				if idxC := storeC.index(e); storeC.isEnabled(idxC) {
+					row.C = &storeC.componentList[idxC]
+				}
+			}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Optional1 iterates over the intersection of the first 3 components
+// along with the following 1 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Optional1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Optional
+	D any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+	}) bool) {
+		each3Optional1[A, B, C, D](w, yield)
+	}
+}
+
+func each3Optional1[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Optional2 iterates over the intersection of the first 3 components
+// along with the following 2 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Optional2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Optional
+	D any,
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each3Optional2[A, B, C, D, E](w, yield)
+	}
+}
+
+func each3Optional2[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.storeLock(), storeE.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each3Optional3 iterates over the intersection of the first 3 components
+// along with the following 3 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each3Optional3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Optional
+	D any,
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each3Optional3[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each3Optional3[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.storeLock(), storeE.storeLock(), storeF.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC]}
+			if okD {
+				if idxD := storeD.index(e); storeD.isEnabled(idxD) {
+					row.D = &storeD.componentList[idxD]
+				}
+			}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Optional1 iterates over the intersection of the first 4 components
+// along with the following 1 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Optional1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Optional
+	E any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+	}) bool) {
+		each4Optional1[A, B, C, D, E](w, yield)
+	}
+}
+
+func each4Optional1[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each4Optional2 iterates over the intersection of the first 4 components
+// along with the following 2 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each4Optional2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Optional
+	E any,
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each4Optional2[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each4Optional2[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.storeLock(), storeF.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD]}
+			if okE {
+				if idxE := storeE.index(e); storeE.isEnabled(idxE) {
+					row.E = &storeE.componentList[idxE]
+				}
+			}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// Each5Optional1 iterates over the intersection of the first 5 components
+// along with the following 1 optional components.
+//
+// It yields each entity along with pointers directly into the packed
+// component arrays. Pointers to optional components are nil when the entity
+// does not have them, or the component was not initialized.
+//
+// Structural changes must not be made while iterating. Use a CommandBuffer.
+//
+// Time Complexity: O(N) where N = min(# Entities of intersected components)
+func Each5Optional1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Optional
+	F any,
+](w *World) iter.Seq2[Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}] {
+	return func(yield func(Entity, struct {
+		A *A
+		B *B
+		C *C
+		D *D
+		E *E
+		F *F
+	}) bool) {
+		each5Optional1[A, B, C, D, E, F](w, yield)
+	}
+}
+
+func each5Optional1[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, yield func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}) bool) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.storeLock())()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		for idxA, e := range storeA.enabledEntities() {
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD], E: &storeE.componentList[idxE]}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenB:
+		for idxB, e := range storeB.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD], E: &storeE.componentList[idxE]}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenC:
+		for idxC, e := range storeC.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD], E: &storeE.componentList[idxE]}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenD:
+		for idxD, e := range storeD.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxE := storeE.index(e)
+			if !storeE.isEnabled(idxE) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD], E: &storeE.componentList[idxE]}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	case lenE:
+		for idxE, e := range storeE.enabledEntities() {
+			idxA := storeA.index(e)
+			if !storeA.isEnabled(idxA) {
+				continue
+			}
+			idxB := storeB.index(e)
+			if !storeB.isEnabled(idxB) {
+				continue
+			}
+			idxC := storeC.index(e)
+			if !storeC.isEnabled(idxC) {
+				continue
+			}
+			idxD := storeD.index(e)
+			if !storeD.isEnabled(idxD) {
+				continue
+			}
+			row := struct {
+				A *A
+				B *B
+				C *C
+				D *D
+				E *E
+				F *F
+			}{A: &storeA.componentList[idxA], B: &storeB.componentList[idxB], C: &storeC.componentList[idxC], D: &storeD.componentList[idxD], E: &storeE.componentList[idxE]}
+			if okF {
+				if idxF := storeF.index(e); storeF.isEnabled(idxF) {
+					row.F = &storeF.componentList[idxF]
+				}
+			}
+			if !yield(e, row) {
+				return
+			}
+		}
+	}
+}
+
+// ParallelEach2 calls fn for the intersection of 2 components from a pool of
+// workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2[
+	// Intersect
+	A any,
+	B any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	if !(okA && okB) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach2Exclude1 calls fn for the intersection of the first 2 components
+// exluding the following 1 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2Exclude1[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach2Exclude2 calls fn for the intersection of the first 2 components
+// exluding the following 2 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2Exclude2[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach2Exclude3 calls fn for the intersection of the first 2 components
+// exluding the following 3 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2Exclude3[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach2Exclude4 calls fn for the intersection of the first 2 components
+// exluding the following 4 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2Exclude4[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach2Exclude5 calls fn for the intersection of the first 2 components
+// exluding the following 5 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2Exclude5[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach2Exclude6 calls fn for the intersection of the first 2 components
+// exluding the following 6 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach2Exclude6[
+	// Intersect
+	A any,
+	B any,
+	// Exclude
+	C any,
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	minLen := min(lenA, lenB)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				if storeC.isEnabled(storeC.index(e)) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB]})
+			}
+		})
+	}
+}
+
+// ParallelEach3 calls fn for the intersection of 3 components from a pool of
+// workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !(okA && okB && okC) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach3Exclude1 calls fn for the intersection of the first 3 components
+// exluding the following 1 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach3Exclude2 calls fn for the intersection of the first 3 components
+// exluding the following 2 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach3Exclude3 calls fn for the intersection of the first 3 components
+// exluding the following 3 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach3Exclude4 calls fn for the intersection of the first 3 components
+// exluding the following 4 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+	G any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach3Exclude5 calls fn for the intersection of the first 3 components
+// exluding the following 5 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach3Exclude6 calls fn for the intersection of the first 3 components
+// exluding the following 6 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach3Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	// Exclude
+	D any,
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	minLen := min(lenA, lenB, lenC)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				if storeD.isEnabled(storeD.index(e)) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC]})
+			}
+		})
+	}
+}
+
+// ParallelEach4 calls fn for the intersection of 4 components from a pool of
+// workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach4Exclude1 calls fn for the intersection of the first 4 components
+// exluding the following 1 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach4Exclude2 calls fn for the intersection of the first 4 components
+// exluding the following 2 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach4Exclude3 calls fn for the intersection of the first 4 components
+// exluding the following 3 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach4Exclude4 calls fn for the intersection of the first 4 components
+// exluding the following 4 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach4Exclude5 calls fn for the intersection of the first 4 components
+// exluding the following 5 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach4Exclude6 calls fn for the intersection of the first 4 components
+// exluding the following 6 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach4Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	// Exclude
+	E any,
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				if storeE.isEnabled(storeE.index(e)) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD]})
+			}
+		})
+	}
+}
+
+// ParallelEach5 calls fn for the intersection of 5 components from a pool of
+// workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach5Exclude1 calls fn for the intersection of the first 5 components
+// exluding the following 1 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach5Exclude2 calls fn for the intersection of the first 5 components
+// exluding the following 2 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach5Exclude3 calls fn for the intersection of the first 5 components
+// exluding the following 3 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach5Exclude4 calls fn for the intersection of the first 5 components
+// exluding the following 4 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach5Exclude5 calls fn for the intersection of the first 5 components
+// exluding the following 5 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach5Exclude6 calls fn for the intersection of the first 5 components
+// exluding the following 6 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach5Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	// Exclude
+	F any,
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				if storeF.isEnabled(storeF.index(e)) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE]})
+			}
+		})
+	}
+}
+
+// ParallelEach6 calls fn for the intersection of 6 components from a pool of
+// workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// ParallelEach6Exclude1 calls fn for the intersection of the first 6 components
+// exluding the following 1 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6Exclude1[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	if !(okA && okB && okC && okD && okE && okF && okG) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// ParallelEach6Exclude2 calls fn for the intersection of the first 6 components
+// exluding the following 2 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6Exclude2[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// ParallelEach6Exclude3 calls fn for the intersection of the first 6 components
+// exluding the following 3 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6Exclude3[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// ParallelEach6Exclude4 calls fn for the intersection of the first 6 components
+// exluding the following 4 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6Exclude4[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// ParallelEach6Exclude5 calls fn for the intersection of the first 6 components
+// exluding the following 5 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6Exclude5[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// ParallelEach6Exclude6 calls fn for the intersection of the first 6 components
+// exluding the following 6 components listed, from a pool of workers.
+//
+// The packed entities of the smallest store are split into chunks which are
+// spread across the workers. It returns once every chunk is processed.
+//
+// Each entity is visited once, so fn may mutate the components it is given. It
+// must not access other components of the same types or make structural
+// changes. Use a CommandBuffer for each worker instead.
+//
+// Time Complexity: O(N/W) where N = min(# Entities of intersected components)
+// and W is the number of workers.
+func ParallelEach6Exclude6[
+	// Intersect
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+	// Exclude
+	G any,
+	H any,
+	I any,
+	J any,
+	K any,
+	L any,
+](w *World, p Parallel, fn func(Entity, struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+})) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	storeG, okG := storeOf[G](w)
+	storeH, okH := storeOf[H](w)
+	storeI, okI := storeOf[I](w)
+	storeJ, okJ := storeOf[J](w)
+	storeK, okK := storeOf[K](w)
+	storeL, okL := storeOf[L](w)
+	if !(okA && okB && okC && okD && okE && okF && okG && okH && okI && okJ && okK && okL) {
+		return
+	}
+	if w.concurrent {
+		defer rlock(storeA.lock, storeB.lock, storeC.lock, storeD.lock, storeE.lock, storeF.lock, storeG.lock, storeH.lock, storeI.lock, storeJ.lock, storeK.lock, storeL.lock)()
+	}
+	lenA := len(storeA.enabledEntities())
+	lenB := len(storeB.enabledEntities())
+	lenC := len(storeC.enabledEntities())
+	lenD := len(storeD.enabledEntities())
+	lenE := len(storeE.enabledEntities())
+	lenF := len(storeF.enabledEntities())
+	minLen := min(lenA, lenB, lenC, lenD, lenE, lenF)
+	switch minLen {
+	case lenA:
+		entities := storeA.enabledEntities()
+		p.run(lenA, func(lo, hi int) {
+			for idxA := lo; idxA < hi; idxA++ {
+				e := entities[idxA]
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				if storeL.isEnabled(storeL.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenB:
+		entities := storeB.enabledEntities()
+		p.run(lenB, func(lo, hi int) {
+			for idxB := lo; idxB < hi; idxB++ {
+				e := entities[idxB]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				if storeL.isEnabled(storeL.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenC:
+		entities := storeC.enabledEntities()
+		p.run(lenC, func(lo, hi int) {
+			for idxC := lo; idxC < hi; idxC++ {
+				e := entities[idxC]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				if storeL.isEnabled(storeL.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenD:
+		entities := storeD.enabledEntities()
+		p.run(lenD, func(lo, hi int) {
+			for idxD := lo; idxD < hi; idxD++ {
+				e := entities[idxD]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				if storeL.isEnabled(storeL.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenE:
+		entities := storeE.enabledEntities()
+		p.run(lenE, func(lo, hi int) {
+			for idxE := lo; idxE < hi; idxE++ {
+				e := entities[idxE]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxF := storeF.index(e)
+				if !storeF.isEnabled(idxF) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				if storeL.isEnabled(storeL.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	case lenF:
+		entities := storeF.enabledEntities()
+		p.run(lenF, func(lo, hi int) {
+			for idxF := lo; idxF < hi; idxF++ {
+				e := entities[idxF]
+				idxA := storeA.index(e)
+				if !storeA.isEnabled(idxA) {
+					continue
+				}
+				idxB := storeB.index(e)
+				if !storeB.isEnabled(idxB) {
+					continue
+				}
+				idxC := storeC.index(e)
+				if !storeC.isEnabled(idxC) {
+					continue
+				}
+				idxD := storeD.index(e)
+				if !storeD.isEnabled(idxD) {
+					continue
+				}
+				idxE := storeE.index(e)
+				if !storeE.isEnabled(idxE) {
+					continue
+				}
+				if storeG.isEnabled(storeG.index(e)) {
+					continue
+				}
+				if storeH.isEnabled(storeH.index(e)) {
+					continue
+				}
+				if storeI.isEnabled(storeI.index(e)) {
+					continue
+				}
+				if storeJ.isEnabled(storeJ.index(e)) {
+					continue
+				}
+				if storeK.isEnabled(storeK.index(e)) {
+					continue
+				}
+				if storeL.isEnabled(storeL.index(e)) {
+					continue
+				}
+				fn(e, struct {
+					A *A
+					B *B
+					C *C
+					D *D
+					E *E
+					F *F
+				}{&storeA.componentList[idxA], &storeB.componentList[idxB], &storeC.componentList[idxC], &storeD.componentList[idxD], &storeE.componentList[idxE], &storeF.componentList[idxF]})
+			}
+		})
+	}
+}
+
+// Group2 returns the packed entities and data of every entity which has all 2
+// components. The slices are aligned and so can be iterated together.
+//
+// The first call creates a group which takes ownership of the component stores
+// and keeps its members packed at the front of each store as components are
+// added and removed. A store may only be owned by a single group.
+//
+// Slices are nil in a concurrent world, or if a store is not initialized or
+// owned by a different group.
+//
+// Time Complexity: O(1) once the group is created.
+func Group2[
+	A any,
+	B any,
+](w *World) ([]Entity, []A, []B) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	if !(okA && okB) {
+		return nil, nil, nil
+	}
+	g := w.group(storeA, storeB)
+	if g == nil {
+		return nil, nil, nil
+	}
+	return storeA.entityList[:g.size], storeA.componentList[:g.size], storeB.componentList[:g.size]
+}
+
+// Group3 returns the packed entities and data of every entity which has all 3
+// components. The slices are aligned and so can be iterated together.
+//
+// The first call creates a group which takes ownership of the component stores
+// and keeps its members packed at the front of each store as components are
+// added and removed. A store may only be owned by a single group.
+//
+// Slices are nil in a concurrent world, or if a store is not initialized or
+// owned by a different group.
+//
+// Time Complexity: O(1) once the group is created.
+func Group3[
+	A any,
+	B any,
+	C any,
+](w *World) ([]Entity, []A, []B, []C) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	if !(okA && okB && okC) {
+		return nil, nil, nil, nil
+	}
+	g := w.group(storeA, storeB, storeC)
+	if g == nil {
+		return nil, nil, nil, nil
+	}
+	return storeA.entityList[:g.size], storeA.componentList[:g.size], storeB.componentList[:g.size], storeC.componentList[:g.size]
+}
+
+// Group4 returns the packed entities and data of every entity which has all 4
+// components. The slices are aligned and so can be iterated together.
+//
+// The first call creates a group which takes ownership of the component stores
+// and keeps its members packed at the front of each store as components are
+// added and removed. A store may only be owned by a single group.
+//
+// Slices are nil in a concurrent world, or if a store is not initialized or
+// owned by a different group.
+//
+// Time Complexity: O(1) once the group is created.
+func Group4[
+	A any,
+	B any,
+	C any,
+	D any,
+](w *World) ([]Entity, []A, []B, []C, []D) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	if !(okA && okB && okC && okD) {
+		return nil, nil, nil, nil, nil
+	}
+	g := w.group(storeA, storeB, storeC, storeD)
+	if g == nil {
+		return nil, nil, nil, nil, nil
+	}
+	return storeA.entityList[:g.size], storeA.componentList[:g.size], storeB.componentList[:g.size], storeC.componentList[:g.size], storeD.componentList[:g.size]
+}
+
+// Group5 returns the packed entities and data of every entity which has all 5
+// components. The slices are aligned and so can be iterated together.
+//
+// The first call creates a group which takes ownership of the component stores
+// and keeps its members packed at the front of each store as components are
+// added and removed. A store may only be owned by a single group.
+//
+// Slices are nil in a concurrent world, or if a store is not initialized or
+// owned by a different group.
+//
+// Time Complexity: O(1) once the group is created.
+func Group5[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+](w *World) ([]Entity, []A, []B, []C, []D, []E) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	if !(okA && okB && okC && okD && okE) {
+		return nil, nil, nil, nil, nil, nil
+	}
+	g := w.group(storeA, storeB, storeC, storeD, storeE)
+	if g == nil {
+		return nil, nil, nil, nil, nil, nil
+	}
+	return storeA.entityList[:g.size], storeA.componentList[:g.size], storeB.componentList[:g.size], storeC.componentList[:g.size], storeD.componentList[:g.size], storeE.componentList[:g.size]
+}
+
+// Group6 returns the packed entities and data of every entity which has all 6
+// components. The slices are aligned and so can be iterated together.
+//
+// The first call creates a group which takes ownership of the component stores
+// and keeps its members packed at the front of each store as components are
+// added and removed. A store may only be owned by a single group.
+//
+// Slices are nil in a concurrent world, or if a store is not initialized or
+// owned by a different group.
+//
+// Time Complexity: O(1) once the group is created.
+func Group6[
+	A any,
+	B any,
+	C any,
+	D any,
+	E any,
+	F any,
+](w *World) ([]Entity, []A, []B, []C, []D, []E, []F) {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	storeC, okC := storeOf[C](w)
+	storeD, okD := storeOf[D](w)
+	storeE, okE := storeOf[E](w)
+	storeF, okF := storeOf[F](w)
+	if !(okA && okB && okC && okD && okE && okF) {
+		return nil, nil, nil, nil, nil, nil, nil
+	}
+	g := w.group(storeA, storeB, storeC, storeD, storeE, storeF)
+	if g == nil {
+		return nil, nil, nil, nil, nil, nil, nil
+	}
+	return storeA.entityList[:g.size], storeA.componentList[:g.size], storeB.componentList[:g.size], storeC.componentList[:g.size], storeD.componentList[:g.size], storeE.componentList[:g.size], storeF.componentList[:g.size]
+}
@@ -152,6 +152,76 @@ func TestQuery(t *testing.T) {
 		es = ecs.Query5[CombatTag, DeadTag, Velocity, Health, Position](&world)
 		testutil.AssertEqual(t, len(es), 0)
 	})
+
+	t.Run("Each2", func(t *testing.T) {
+		count := 0
+		for e, c := range ecs.Each2[Position, Velocity](&world) {
+			testutil.AssertEqual(t, c.A.z, c.B.z)
+			c.A.x += 1.0
+			pos, _ := ecs.Get[Position](&world, e)
+			testutil.AssertEqual(t, pos.x, 1.0)
+			c.A.x -= 1.0
+			count++
+		}
+		testutil.AssertEqual(t, count, 3)
+
+		for range ecs.Each2[CombatTag, DeadTag](&world) {
+			t.Error("Expected empty intersection.")
+		}
+
+		allocs := testing.AllocsPerRun(10, func() {
+			for _, c := range ecs.Each2[Position, Velocity](&world) {
+				c.A.x += c.B.x
+			}
+		})
+		testutil.AssertEqual(t, allocs, 0.0)
+	})
+
+	t.Run("Each2Exclude1", func(t *testing.T) {
+		seen := make([]bool, world.EntityCount()+1)
+		for e, c := range ecs.Each2Exclude1[Position, Health, Velocity](&world) {
+			testutil.AssertEqual(t, c.B.hp > 0 || e == npc1, true)
+			seen[e.ID()] = true
+		}
+		testutil.AssertEqual(t, seen[player.ID()], false)
+		testutil.AssertEqual(t, seen[npc1.ID()], true)
+		testutil.AssertEqual(t, seen[npc2.ID()], false)
+		testutil.AssertEqual(t, seen[npc3.ID()], false)
+		testutil.AssertEqual(t, seen[wall.ID()], true)
+	})
+
+	t.Run("Each3Break", func(t *testing.T) {
+		count := 0
+		for range ecs.Each3[Position, Velocity, Health](&world) {
+			count++
+			break
+		}
+		testutil.AssertEqual(t, count, 1)
+	})
+
+	t.Run("Each2Optional1", func(t *testing.T) {
+		count := 0
+		for e, c := range ecs.Each2Optional1[Position, Health, Velocity](&world) {
+			v, ok := ecs.Get[Velocity](&world, e)
+			testutil.AssertEqual(t, c.C != nil, ok)
+			if ok {
+				testutil.AssertEqual(t, *c.C, v)
+			}
+			hp, _ := ecs.Get[Health](&world, e)
+			testutil.AssertEqual(t, *c.B, hp)
+			count++
+		}
+		testutil.AssertEqual(t, count, 5)
+	})
+
+	t.Run("Each1Optional1", func(t *testing.T) {
+		count := 0
+		for _, c := range ecs.Each1Optional1[CombatTag, Score](&world) {
+			testutil.AssertEqual(t, c.B == nil, true)
+			count++
+		}
+		testutil.AssertEqual(t, count, 2)
+	})
 }
 
 var loc int
@@ -223,6 +293,14 @@ func BenchmarkQuery(b *testing.B) {
 		}
 		b.StopTimer()
 	})
+	b.Run("Each2", func(b *testing.B) {
+		b.ReportAllocs()
+		b.StartTimer()
+		for _, c := range ecs.Each2[Position, Health](&world) {
+			loc += c.B.hp
+		}
+		b.StopTimer()
+	})
 	b.Run("QueryExclude", func(b *testing.B) {
 		b.StartTimer()
 		es := ecs.QueryExclude[Position, Health](&world)
@@ -0,0 +1,32 @@
+package ecs
+
+// SetResource stores a singleton of type T on the world, replacing any value
+// already set in place so references remain valid. Resources are global data
+// such as the time delta or input state, and need no ComponentID.
+//
+// Resources are not saved in snapshots.
+func SetResource[T any](w *World, v T) {
+	if r, ok := w.resources[typeKey[T]()].(*T); ok {
+		*r = v
+		return
+	}
+	w.resources[typeKey[T]()] = &v
+}
+
+// Resource returns a mutable reference to the resource of type T.
+//
+// Reference is nil if the resource was not set.
+func Resource[T any](w *World) *T {
+	r, _ := w.resources[typeKey[T]()].(*T)
+	return r
+}
+
+// RemoveResource removes the resource of type T from the world.
+func RemoveResource[T any](w *World) bool {
+	key := typeKey[T]()
+	if _, ok := w.resources[key]; !ok {
+		return false
+	}
+	delete(w.resources, key)
+	return true
+}
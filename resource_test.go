@@ -0,0 +1,42 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+type Time struct {
+	Delta float32
+}
+
+type Input struct {
+	Jump bool
+}
+
+func TestResource(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	testutil.AssertEqual(t, ecs.Resource[Time](&world) == nil, true)
+
+	ecs.SetResource(&world, Time{0.016})
+	ecs.SetResource(&world, Input{})
+	time := ecs.Resource[Time](&world)
+	testutil.AssertEqual(t, time.Delta, float32(0.016))
+
+	time.Delta = 0.032
+	testutil.AssertEqual(t, ecs.Resource[Time](&world).Delta, float32(0.032))
+
+	ecs.SetResource(&world, Time{0.008})
+	testutil.AssertEqual(t, time.Delta, float32(0.008))
+	testutil.AssertEqual(t, ecs.Resource[Input](&world).Jump, false)
+
+	testutil.AssertEqual(t, ecs.RemoveResource[Time](&world), true)
+	testutil.AssertEqual(t, ecs.RemoveResource[Time](&world), false)
+	testutil.AssertEqual(t, ecs.Resource[Time](&world) == nil, true)
+	testutil.AssertEqual(t, ecs.Resource[Input](&world) != nil, true)
+}
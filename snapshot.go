@@ -0,0 +1,363 @@
+package ecs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+	"unsafe"
+
+	"github.com/jdavasligil/go-ecs/pkg/bitset"
+	"github.com/jdavasligil/go-ecs/pkg/queue"
+)
+
+// SNAPSHOT_VERSION is the version of the binary snapshot format.
+const SNAPSHOT_VERSION uint16 = 3
+
+var snapshotMagic = [4]byte{'G', 'E', 'C', 'S'}
+
+// Codec encodes and decodes the data of a single component for snapshots.
+type Codec[T any] interface {
+	Encode(w io.Writer, c *T) error
+	Decode(r io.Reader, c *T) error
+}
+
+// BinaryCodec is a Codec for fixed-size components with exported fields. It
+// uses encoding/binary in little endian byte order.
+type BinaryCodec[T any] struct{}
+
+func (BinaryCodec[T]) Encode(w io.Writer, c *T) error {
+	return binary.Write(w, binary.LittleEndian, c)
+}
+
+func (BinaryCodec[T]) Decode(r io.Reader, c *T) error {
+	return binary.Read(r, binary.LittleEndian, c)
+}
+
+// SetCodec opts a component into snapshots. It should be called next to
+// Initialize.
+func SetCodec[T any](w *World, codec Codec[T]) bool {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return false
+	}
+	store.codec = codec
+	return true
+}
+
+// snapshotter is implemented by component stores which can be saved.
+type snapshotter interface {
+	hasCodec() bool
+	encode(w io.Writer) error
+
+	// decode reads the store and returns a function which applies it. The
+	// entities of the store must be living in the decoded entity table.
+	decode(r io.Reader, em *entityManager) (func(), error)
+
+	// discard tells the observers of the store that each component is about
+	// to be thrown away by a restore.
+	discard()
+}
+
+// Snapshot writes the entities and every component store with a codec to the
+// writer in a versioned binary format.
+//
+// Stores without a codec are not saved. Disabled components and entities are
+// saved as disabled.
+func (w *World) Snapshot(out io.Writer) error {
+	if err := write(out, snapshotMagic, SNAPSHOT_VERSION, entitySize()); err != nil {
+		return err
+	}
+	if err := w.entities.encode(out); err != nil {
+		return err
+	}
+
+	stores := make([]ComponentID, 0)
+	for id, s := range w.components {
+		if ss, ok := s.(snapshotter); ok && ss.hasCodec() {
+			stores = append(stores, ComponentID(id))
+		}
+	}
+	if err := write(out, uint32(len(stores))); err != nil {
+		return err
+	}
+	for _, id := range stores {
+		if err := write(out, uint32(id)); err != nil {
+			return err
+		}
+		if err := w.components[id].(snapshotter).encode(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore replaces the entities and components of the world with a snapshot.
+// Entity IDs and versions are preserved so saved references remain valid.
+//
+// Every store in the snapshot must be initialized with a codec. Stores which
+// are not in the snapshot are emptied. The world is unchanged upon failure.
+//
+// Observers are told of the removal of every component before the world is
+// replaced, and then of the addition of every restored component.
+func (w *World) Restore(in io.Reader) error {
+	var magic [4]byte
+	var version uint16
+	var size uint8
+	if err := read(in, &magic, &version, &size); err != nil {
+		return err
+	}
+	if magic != snapshotMagic || version != SNAPSHOT_VERSION || size != entitySize() {
+		return ErrSnapshotFormat
+	}
+
+	entities, err := w.entities.decode(in)
+	if err != nil {
+		return err
+	}
+
+	var count uint32
+	if err := read(in, &count); err != nil {
+		return err
+	}
+	if count > uint32(len(w.components)) {
+		return ErrSnapshotFormat
+	}
+	restored := make([]bool, len(w.components))
+	applyStores := make([]func(), 0, count)
+	for i := uint32(0); i < count; i++ {
+		var id uint32
+		if err := read(in, &id); err != nil {
+			return err
+		}
+		if int(id) >= len(w.components) || restored[id] {
+			return fmt.Errorf("%w: component %d", ErrSnapshotStore, id)
+		}
+		ss, ok := w.components[id].(snapshotter)
+		if !ok || !ss.hasCodec() {
+			return fmt.Errorf("%w: component %d", ErrSnapshotStore, id)
+		}
+		apply, err := ss.decode(in, entities)
+		if err != nil {
+			return err
+		}
+		restored[id] = true
+		applyStores = append(applyStores, apply)
+	}
+
+	for _, id := range w.initialized {
+		w.components[id].(snapshotter).discard()
+	}
+	w.entities.restore(entities)
+	if w.hierarchy != nil {
+		w.hierarchy.nodes.Reset()
+	}
+	for _, id := range w.initialized {
+		if !restored[id] {
+			w.components[id].Reset()
+		}
+	}
+	for _, apply := range applyStores {
+		apply()
+	}
+	return nil
+}
+
+func (em *entityManager) encode(out io.Writer) error {
+	bin := make([]Entity, em.bin.Len())
+	for i := range bin {
+		bin[i] = em.bin.At(i)
+	}
+	return write(out,
+		em.next,
+		em.size,
+		uint32(len(em.versions)),
+		em.versions,
+		em.alive,
+		em.disabled,
+		uint32(len(bin)),
+		bin,
+	)
+}
+
+// decode reads an entity table with the limits of the manager. It is applied
+// with restore.
+func (em *entityManager) decode(in io.Reader) (*entityManager, error) {
+	var next, size, count uint32
+	if err := read(in, &next, &size, &count); err != nil {
+		return nil, err
+	}
+	if size > em.MaxEntities || count > em.MaxEntities || next != count {
+		return nil, ErrSnapshotFormat
+	}
+	versions := make([]Generation, count)
+	alive := make([]bool, count)
+	disabled := make([]bool, count)
+	if err := read(in, versions, alive, disabled, &count); err != nil {
+		return nil, err
+	}
+	living := uint32(0)
+	for i := range alive {
+		if disabled[i] && !alive[i] {
+			return nil, ErrSnapshotFormat
+		}
+		if alive[i] {
+			living++
+		}
+	}
+	if living != size {
+		return nil, ErrSnapshotFormat
+	}
+	if count > em.MaxRecycle {
+		return nil, ErrSnapshotFormat
+	}
+	bin := make([]Entity, count)
+	if err := read(in, bin); err != nil {
+		return nil, err
+	}
+	decoded := &entityManager{
+		MaxEntities: em.MaxEntities,
+		MaxRecycle:  em.MaxRecycle,
+		bin:         queue.NewRingBuffer[Entity](int(em.MaxRecycle)),
+		size:        size,
+		next:        next,
+		versions:    versions,
+		alive:       alive,
+		disabled:    disabled,
+		reserved:    make([]bool, len(alive)),
+	}
+	for _, e := range bin {
+		if e.ID() >= next || alive[e.ID()] {
+			return nil, ErrSnapshotFormat
+		}
+		decoded.bin.Push(e)
+	}
+	return decoded, nil
+}
+
+// restore replaces the entity table with one read by decode.
+func (em *entityManager) restore(decoded *entityManager) {
+	em.next = decoded.next
+	em.size = decoded.size
+	em.versions = decoded.versions
+	em.alive = decoded.alive
+	em.disabled = decoded.disabled
+	em.reserved = decoded.reserved
+	em.pending = 0
+	em.bin = decoded.bin
+}
+
+func (p *componentStore[T]) hasCodec() bool {
+	return p.codec != nil
+}
+
+func (p *componentStore[T]) encode(out io.Writer) error {
+	if err := write(out, uint32(len(p.entityList)), p.entityList); err != nil {
+		return err
+	}
+	for i := range p.componentList {
+		if err := p.codec.Encode(out, &p.componentList[i]); err != nil {
+			return err
+		}
+	}
+	// Components hidden only because their entity is disabled are left out.
+	disabled := make([]Entity, 0)
+	for _, e := range p.entityList[p.enabled:] {
+		if hasBit(p.disabled, int(e.ID())) {
+			disabled = append(disabled, e)
+		}
+	}
+	return write(out, uint32(len(disabled)), disabled)
+}
+
+func (p *componentStore[T]) decode(in io.Reader, em *entityManager) (func(), error) {
+	var count uint32
+	if err := read(in, &count); err != nil {
+		return nil, err
+	}
+	if count > em.size {
+		return nil, ErrSnapshotFormat
+	}
+	entities := make([]Entity, count)
+	if err := read(in, entities); err != nil {
+		return nil, err
+	}
+	var seen bitset.BitsetUint64
+	for _, e := range entities {
+		id := int(e.ID())
+		if !em.isAlive(e) || hasBit(seen, id) {
+			return nil, ErrSnapshotFormat
+		}
+		setBit(&seen, id)
+	}
+	components := make([]T, count)
+	for i := range components {
+		if err := p.codec.Decode(in, &components[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := read(in, &count); err != nil {
+		return nil, err
+	}
+	if count > uint32(len(entities)) {
+		return nil, ErrSnapshotFormat
+	}
+	disabled := make([]Entity, count)
+	if err := read(in, disabled); err != nil {
+		return nil, err
+	}
+	for _, e := range disabled {
+		if !hasBit(seen, int(e.ID())) {
+			return nil, ErrSnapshotFormat
+		}
+	}
+	return func() {
+		p.Reset()
+		for i, e := range entities {
+			p.Add(e, components[i])
+		}
+		for _, e := range disabled {
+			p.Disable(e)
+		}
+	}, nil
+}
+
+func (p *componentStore[T]) discard() {
+	if len(p.observers) == 0 {
+		return
+	}
+	// Observers may change the store, so the entities are copied first.
+	for _, e := range slices.Clone(p.entityList) {
+		for _, o := range p.observers {
+			if o.OnRemove != nil {
+				o.OnRemove(p.world, e)
+			}
+		}
+	}
+}
+
+// entitySize is the width of an Entity in bytes. Snapshots can only be restored
+// by a build with the same entity layout.
+func entitySize() uint8 {
+	return uint8(unsafe.Sizeof(Entity(0)))
+}
+
+// write encodes each value in little endian byte order.
+func write(out io.Writer, values ...any) error {
+	for _, v := range values {
+		if err := binary.Write(out, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read decodes each value in little endian byte order.
+func read(in io.Reader, values ...any) error {
+	for _, v := range values {
+		if err := binary.Read(in, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
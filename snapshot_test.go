@@ -0,0 +1,226 @@
+package ecs_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+// positionCodec encodes the unexported fields of Position.
+type positionCodec struct{}
+
+func (positionCodec) Encode(w io.Writer, c *Position) error {
+	return binary.Write(w, binary.LittleEndian, [3]float32{c.x, c.y, c.z})
+}
+
+func (positionCodec) Decode(r io.Reader, c *Position) error {
+	var v [3]float32
+	err := binary.Read(r, binary.LittleEndian, &v)
+	*c = Position{v[0], v[1], v[2]}
+	return err
+}
+
+func newSnapshotWorld() ecs.World {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Score](&world)
+	ecs.Initialize[Health](&world)
+	ecs.SetCodec[Position](&world, positionCodec{})
+	ecs.SetCodec[Score](&world, ecs.BinaryCodec[Score]{})
+	return world
+}
+
+func TestSnapshot(t *testing.T) {
+	world := newSnapshotWorld()
+	entities := make([]ecs.Entity, 8)
+	for i := range entities {
+		entities[i] = world.NewEntity()
+		ecs.Add(&world, entities[i], Position{float32(i), 1.0, 2.0})
+		ecs.Add(&world, entities[i], Health{i})
+		if i%2 == 0 {
+			ecs.Add(&world, entities[i], Score{int32(i * 100), uint8(i)})
+		}
+	}
+	world.DestroyEntity(entities[3])
+	entities[3] = world.NewEntity()
+	world.DestroyEntity(entities[5])
+
+	var buf bytes.Buffer
+	testutil.AssertEqual(t, world.Snapshot(&buf), nil)
+	saved := buf.Bytes()
+
+	t.Run("Restore", func(t *testing.T) {
+		restored := newSnapshotWorld()
+		other := restored.NewEntity()
+		ecs.Add(&restored, other, Health{99})
+
+		testutil.AssertEqual(t, restored.Restore(bytes.NewReader(saved)), nil)
+		testutil.AssertEqual(t, restored.EntityCount(), world.EntityCount())
+
+		for i, e := range entities {
+			testutil.AssertEqual(t, restored.IsAlive(e), i != 5)
+			if i == 3 || i == 5 {
+				continue
+			}
+			p, ok := ecs.Get[Position](&restored, e)
+			testutil.AssertEqual(t, ok, true)
+			testutil.AssertEqual(t, p, Position{float32(i), 1.0, 2.0})
+			s, ok := ecs.Get[Score](&restored, e)
+			testutil.AssertEqual(t, ok, i%2 == 0)
+			if ok {
+				testutil.AssertEqual(t, s, Score{int32(i * 100), uint8(i)})
+			}
+		}
+		hs, _ := ecs.Query[Health](&restored)
+		testutil.AssertEqual(t, len(hs), 0)
+
+		testutil.AssertEqual(t, restored.NewEntity(), world.NewEntity())
+	})
+
+	t.Run("Observers", func(t *testing.T) {
+		restored := newSnapshotWorld()
+		other := restored.NewEntity()
+		ecs.Add(&restored, other, Health{99})
+		ecs.Add(&restored, other, Position{})
+
+		added, removed := 0, 0
+		observer := ecs.Observer{
+			OnAdd: func(w *ecs.World, e ecs.Entity) { added++ },
+			OnRemove: func(w *ecs.World, e ecs.Entity) {
+				testutil.AssertEqual(t, e, other)
+				testutil.AssertEqual(t, w.IsAlive(e), true)
+				removed++
+			},
+		}
+		ecs.Observe[Health](&restored, observer)
+		ecs.Observe[Position](&restored, observer)
+
+		testutil.AssertEqual(t, restored.Restore(bytes.NewReader(saved)), nil)
+		es, _ := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, added, len(es))
+		testutil.AssertEqual(t, removed, 2)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		restored := newSnapshotWorld()
+		err := restored.Restore(bytes.NewReader([]byte("not a snapshot")))
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotFormat), true)
+
+		truncated := saved[:len(saved)-1]
+		keep := restored.NewEntity()
+		err = restored.Restore(bytes.NewReader(truncated))
+		testutil.AssertEqual(t, err != nil, true)
+		testutil.AssertEqual(t, restored.IsAlive(keep), true)
+
+		// The entity IDs in use must fit the limit of the world.
+		small := ecs.NewWorld(ecs.WorldOptions{
+			EntityLimit:    4,
+			RecycleLimit:   4,
+			ComponentLimit: 255,
+		})
+		sparse := newSnapshotWorld()
+		ids := make([]ecs.Entity, 5)
+		for i := range ids {
+			ids[i] = sparse.NewEntity()
+		}
+		for _, e := range ids[:4] {
+			sparse.DestroyEntity(e)
+		}
+		buf.Reset()
+		testutil.AssertEqual(t, sparse.Snapshot(&buf), nil)
+		err = small.Restore(&buf)
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotFormat), true)
+
+		// A store listing the same entity twice is refused.
+		twice := newSnapshotWorld()
+		first, second := twice.NewEntity(), twice.NewEntity()
+		ecs.Add(&twice, first, Position{1.0, 1.0, 1.0})
+		ecs.Add(&twice, second, Position{1.0, 1.0, 1.0})
+		buf.Reset()
+		testutil.AssertEqual(t, twice.Snapshot(&buf), nil)
+		encode := func(e ecs.Entity) []byte {
+			b, _ := binary.Append(nil, binary.LittleEndian, e)
+			return b
+		}
+		data := buf.Bytes()
+		copy(data[bytes.LastIndex(data, encode(second)):], encode(first))
+		restored = newSnapshotWorld()
+		err = restored.Restore(bytes.NewReader(data))
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotFormat), true)
+
+		// A store listing a dead entity is refused.
+		dead := twice.NewEntity()
+		twice.DestroyEntity(dead)
+		buf.Reset()
+		testutil.AssertEqual(t, twice.Snapshot(&buf), nil)
+		data = buf.Bytes()
+		copy(data[bytes.LastIndex(data, encode(second)):], encode(dead))
+		err = restored.Restore(bytes.NewReader(data))
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotFormat), true)
+
+		// The count of living entities must match the entity table. It follows
+		// the header and the next ID.
+		buf.Reset()
+		testutil.AssertEqual(t, twice.Snapshot(&buf), nil)
+		data = buf.Bytes()
+		binary.LittleEndian.PutUint32(data[11:], binary.LittleEndian.Uint32(data[11:])+1)
+		err = restored.Restore(bytes.NewReader(data))
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotFormat), true)
+
+		// The count of stores must fit the component table. It is the end of a
+		// snapshot without stores.
+		empty := ecs.NewWorld(ecs.WorldOptions{
+			EntityLimit:    1024,
+			RecycleLimit:   1024,
+			ComponentLimit: 255,
+		})
+		buf.Reset()
+		testutil.AssertEqual(t, empty.Snapshot(&buf), nil)
+		data = buf.Bytes()
+		binary.LittleEndian.PutUint32(data[len(data)-4:], 0xFFFFFFFF)
+		err = restored.Restore(bytes.NewReader(data))
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotFormat), true)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		world.DisableEntity(entities[0])
+		ecs.Disable[Position](&world, entities[1])
+		ecs.Disable[Score](&world, entities[0])
+		buf.Reset()
+		testutil.AssertEqual(t, world.Snapshot(&buf), nil)
+
+		restored := newSnapshotWorld()
+		testutil.AssertEqual(t, restored.Restore(&buf), nil)
+		testutil.AssertEqual(t, restored.IsEnabled(entities[0]), false)
+		testutil.AssertEqual(t, restored.IsEnabled(entities[1]), true)
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&restored, entities[1]), false)
+		testutil.AssertEqual(t, ecs.IsEnabled[Score](&restored, entities[2]), true)
+		es, _ := ecs.Disabled[Position](&restored)
+		testutil.AssertEqual(t, len(es), 2)
+
+		// Components disabled on their own stay so once the entity is enabled.
+		restored.EnableEntity(entities[0])
+		testutil.AssertEqual(t, ecs.IsEnabled[Position](&restored, entities[0]), true)
+		testutil.AssertEqual(t, ecs.IsEnabled[Score](&restored, entities[0]), false)
+	})
+
+	t.Run("MissingCodec", func(t *testing.T) {
+		restored := ecs.NewWorld(ecs.WorldOptions{
+			EntityLimit:    1024,
+			RecycleLimit:   1024,
+			ComponentLimit: 255,
+		})
+		ecs.Initialize[Position](&restored)
+		err := restored.Restore(bytes.NewReader(saved))
+		testutil.AssertEqual(t, errors.Is(err, ecs.ErrSnapshotStore), true)
+	})
+}
@@ -0,0 +1,158 @@
+package ecs
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Sort reorders the packed arrays of component T so that queries and iterators
+// visit the components in the order given by less. The sort is stable, and
+// less must not access the world.
+//
+// The order is not maintained. Add appends to the end and Remove moves the last
+// component into the gap, so sort again after structural changes.
+//
+// Disabled components are sorted on their own, behind the enabled ones. Stores
+// owned by a group are refused, as are components which were not initialized.
+// Tags are always in order of entity ID, so they are left as is.
+//
+// Time Complexity: O(N log N) where N = # Entities with T
+func Sort[T any](w *World, less func(a, b *T) bool) bool {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return false
+	}
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if store.group != nil {
+		return false
+	}
+	if store.tags != nil {
+		return true
+	}
+	perm := identity(len(store.entityList))
+	for _, part := range store.parts(perm) {
+		slices.SortStableFunc(part, func(i, j int) int {
+			a, b := &store.componentList[i], &store.componentList[j]
+			switch {
+			case less(a, b):
+				return -1
+			case less(b, a):
+				return 1
+			}
+			return 0
+		})
+	}
+	store.permute(perm)
+	return true
+}
+
+// SortByEntity reorders the packed arrays of component T in order of entity
+// ID. Iterating in the order entities were created gives deterministic results
+// regardless of the order components were added and removed in.
+//
+// The order is not maintained. See Sort.
+//
+// Time Complexity: O(N log N) where N = # Entities with T
+func SortByEntity[T any](w *World) bool {
+	store, ok := storeOf[T](w)
+	if !ok {
+		return false
+	}
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if store.group != nil {
+		return false
+	}
+	if store.tags != nil {
+		return true
+	}
+	perm := identity(len(store.entityList))
+	for _, part := range store.parts(perm) {
+		slices.SortFunc(part, func(i, j int) int {
+			return cmp.Compare(store.entityList[i].ID(), store.entityList[j].ID())
+		})
+	}
+	store.permute(perm)
+	return true
+}
+
+// SortLike reorders the packed arrays of component B to follow the order of
+// component A. Entities with both components come first in the order of A,
+// followed by the entities without A in their current order. Iterating over
+// the front of both stores then visits the same entities in lockstep. Disabled
+// components of B stay behind the enabled ones in their current order.
+//
+// Only the store of B is changed, so it is refused if owned by a group or if
+// it has TagStorage. The order is not maintained. See Sort.
+//
+// Time Complexity: O(N + M) where N = # Entities with A and M = # Entities
+// with B
+func SortLike[A any, B any](w *World) bool {
+	storeA, okA := storeOf[A](w)
+	storeB, okB := storeOf[B](w)
+	if !(okA && okB) {
+		return false
+	}
+	// The order of A is copied in a concurrent world, so only the lock of B is
+	// held while sorting.
+	order := storeA.Entities()
+
+	storeB.lock.Lock()
+	defer storeB.lock.Unlock()
+	if storeB.group != nil || storeB.tags != nil {
+		return false
+	}
+	perm := make([]int, 0, len(storeB.entityList))
+	sorted := make([]bool, len(storeB.entityList))
+	for _, e := range order {
+		if idx := storeB.index(e); storeB.isEnabled(idx) {
+			perm = append(perm, idx)
+			sorted[idx] = true
+		}
+	}
+	for idx := range storeB.entityList {
+		if !sorted[idx] {
+			perm = append(perm, idx)
+		}
+	}
+	storeB.permute(perm)
+	return true
+}
+
+// identity returns the permutation of n elements which changes nothing.
+func identity(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// parts splits a permutation of the packed arrays into the enabled and the
+// disabled components, which are sorted apart.
+func (p *componentStore[T]) parts(perm []int) [2][]int {
+	return [2][]int{perm[:p.enabled], perm[p.enabled:]}
+}
+
+// permute rearranges the packed arrays so the element at position perm[i]
+// moves to position i, and notifies observers of every element which moved.
+func (p *componentStore[T]) permute(perm []int) {
+	entities := make([]Entity, len(perm), cap(p.entityList))
+	components := make([]T, len(perm), cap(p.componentList))
+	ticks := make([]componentTicks, len(perm), cap(p.ticks))
+	for i, j := range perm {
+		entities[i] = p.entityList[j]
+		components[i] = p.componentList[j]
+		ticks[i] = p.ticks[j]
+		p.entityIndices.Set(int(entities[i].ID()), i)
+	}
+	p.entityList = entities
+	p.componentList = components
+	p.ticks = ticks
+	for i, j := range perm {
+		if i != j {
+			p.moved(i)
+		}
+	}
+}
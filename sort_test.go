@@ -0,0 +1,119 @@
+package ecs_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestSort(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Velocity](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[DeadTag](&world)
+
+	// Depths are added out of order, with ties, and with holes from removals.
+	depths := []float32{5, 3, 9, 1, 3, 7, 0, 3}
+	entities := make([]ecs.Entity, len(depths))
+	for i, z := range depths {
+		entities[i] = world.NewEntity()
+		ecs.Add(&world, entities[i], Position{z: z})
+		ecs.Add(&world, entities[i], Health{hp: i})
+	}
+	ecs.Remove[Position](&world, entities[0])
+	ecs.Remove[Health](&world, entities[0])
+
+	// index is an external index kept in sync with the packed Position array.
+	index := make(map[ecs.Entity]int)
+	es, _ := ecs.Query[Position](&world)
+	for i, e := range es {
+		index[e] = i
+	}
+	ecs.Observe[Position](&world, ecs.Observer{
+		OnMove: func(w *ecs.World, e ecs.Entity, i int) { index[e] = i },
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		tick := world.Advance()
+		testutil.AssertEqual(t, ecs.Sort(&world, func(a, b *Position) bool {
+			return a.z < b.z
+		}), true)
+
+		es, ps := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, len(ps), len(depths)-1)
+		for i := 1; i < len(ps); i++ {
+			testutil.AssertEqual(t, ps[i-1].z <= ps[i].z, true)
+		}
+		// Ties keep their previous order, where Remove moved the last entity
+		// into the gap at the front.
+		testutil.AssertEqual(t, es[2], entities[7])
+		testutil.AssertEqual(t, es[3], entities[1])
+		testutil.AssertEqual(t, es[4], entities[4])
+
+		for i, e := range es {
+			p, _ := ecs.Get[Position](&world, e)
+			testutil.AssertEqual(t, p, ps[i])
+			testutil.AssertEqual(t, index[e], i)
+		}
+		// Sorting does not count as a change.
+		testutil.AssertEqual(t, len(ecs.Where(es, ecs.Changed[Position](&world, tick))), 0)
+	})
+
+	t.Run("SortLike", func(t *testing.T) {
+		// Velocity is only on some entities, in reverse order of creation.
+		for i := len(entities) - 1; i >= 0; i-- {
+			if i%3 == 0 {
+				ecs.Add(&world, entities[i], Velocity{x: float32(i)})
+			}
+		}
+		testutil.AssertEqual(t, ecs.SortLike[Position, Health](&world), true)
+		testutil.AssertEqual(t, ecs.SortLike[Position, Velocity](&world), true)
+
+		pes, _ := ecs.Query[Position](&world)
+		hes, hs := ecs.Query[Health](&world)
+		testutil.AssertEqual(t, slices.Equal(hes, pes), true)
+		for i, e := range hes {
+			h, _ := ecs.Get[Health](&world, e)
+			testutil.AssertEqual(t, hs[i], h)
+		}
+
+		// entities[0] has Velocity but no Position, so it follows the rest.
+		ves, vs := ecs.Query[Velocity](&world)
+		testutil.AssertEqual(t, ves[len(ves)-1], entities[0])
+		j := 0
+		for _, e := range pes {
+			if v, ok := ecs.Get[Velocity](&world, e); ok {
+				testutil.AssertEqual(t, ves[j], e)
+				testutil.AssertEqual(t, vs[j], v)
+				j++
+			}
+		}
+		testutil.AssertEqual(t, j, len(ves)-1)
+	})
+
+	t.Run("SortByEntity", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.SortByEntity[Position](&world), true)
+		es, _ := ecs.Query[Position](&world)
+		testutil.AssertEqual(t, slices.Equal(es, entities[1:]), true)
+		for i, e := range es {
+			testutil.AssertEqual(t, index[e], i)
+		}
+	})
+
+	t.Run("Refused", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.SortByEntity[CombatTag](&world), false)
+		testutil.AssertEqual(t, ecs.SortLike[CombatTag, Health](&world), false)
+
+		ecs.Group2[Health, DeadTag](&world)
+		testutil.AssertEqual(t, ecs.SortByEntity[Health](&world), false)
+		testutil.AssertEqual(t, ecs.SortLike[Position, Health](&world), false)
+		testutil.AssertEqual(t, ecs.SortLike[Health, Position](&world), true)
+	})
+}
@@ -0,0 +1,136 @@
+package ecs
+
+import (
+	"cmp"
+	"slices"
+	"unsafe"
+
+	"github.com/jdavasligil/go-ecs/pkg/bitset"
+)
+
+// Storage decides how a component store keeps track of its entities.
+type Storage uint8
+
+const (
+	// DenseStorage keeps the components packed alongside a sparse array of
+	// indices. It is the default for every component.
+	DenseStorage Storage = iota
+
+	// TagStorage keeps a bitset keyed by entity ID for zero-size components,
+	// which have no data to store. Membership takes a bit per entity ID rather
+	// than a page of indices, and exclude checks are cheaper.
+	//
+	// Tags are always iterated in order of entity ID, so adding and removing
+	// them takes O(N), and observers are not told when they move. They do not
+	// record ticks, so Added and Changed match nothing and MarkChanged is
+	// refused, and they cannot be owned by a group.
+	TagStorage
+)
+
+// SetStorage chooses the storage of a component. It should be called next to
+// Initialize, as the store must be empty.
+//
+// TagStorage is refused for components which are not zero-size, and stores
+// owned by a group are refused.
+func SetStorage[T any](w *World, s Storage) bool {
+	store, ok := storeOf[T](w)
+	if !ok || store.group != nil || store.Len() > 0 {
+		return false
+	}
+	switch s {
+	case DenseStorage:
+		store.tagBits = nil
+		store.tags = nil
+	case TagStorage:
+		var noop T
+		if unsafe.Sizeof(noop) != 0 {
+			return false
+		}
+		store.tags = newTagSet()
+	default:
+		return false
+	}
+	return true
+}
+
+// tagSet holds the entities whose tag is disabled, which are left out of the
+// bitset of the membership so that queries skip them.
+type tagSet struct {
+	inactive bitset.BitsetUint64
+}
+
+func newTagSet() *tagSet {
+	return &tagSet{}
+}
+
+// tag adds the entity to a tag store as disabled. See show.
+//
+// Time Complexity: O(N) where N = # Entities with T
+func (p *componentStore[T]) tag(e Entity, c T) {
+	setBit(&p.tags.inactive, int(e.ID()))
+	p.entityList = slices.Insert(p.entityList, p.enabled+position(p.entityList[p.enabled:], e), e)
+	// The components are zero-size, so they take no memory.
+	p.componentList = append(p.componentList, c)
+}
+
+// untag removes the entity from a tag store.
+//
+// Time Complexity: O(N) where N = # Entities with T
+func (p *componentStore[T]) untag(e Entity) {
+	var i int
+	if id := int(e.ID()); hasBit(p.tagBits, id) {
+		p.tagBits.ClearBit(id)
+		i = position(p.entityList[:p.enabled], e)
+		p.enabled--
+	} else {
+		p.tags.inactive.ClearBit(id)
+		i = p.enabled + position(p.entityList[p.enabled:], e)
+	}
+	p.entityList = slices.Delete(p.entityList, i, i+1)
+	p.componentList = p.componentList[:len(p.componentList)-1]
+}
+
+func (p *componentStore[T]) tagged() bool {
+	return p.tags != nil
+}
+
+// position returns where the entity belongs among entities in order of ID.
+// Both the enabled and the disabled entities of a tag store are kept in order.
+func position(es []Entity, e Entity) int {
+	i, _ := slices.BinarySearchFunc(es, e.ID(), func(x Entity, id uint32) int {
+		return cmp.Compare(x.ID(), id)
+	})
+	return i
+}
+
+// shift moves the entity at position i of the packed array to position j,
+// shifting the entities in between by one.
+func shift(es []Entity, i, j int) {
+	e := es[i]
+	if i < j {
+		copy(es[i:j], es[i+1:j+1])
+	} else {
+		copy(es[j+1:i+1], es[j:i])
+	}
+	es[j] = e
+}
+
+// hasBit reports whether the bit is set, where bits past the end are unset.
+func hasBit(b bitset.BitsetUint64, i int) bool {
+	return i < b.Len() && b.GetBit(i)
+}
+
+// setBit sets the bit, growing the bitset to fit it.
+func setBit(b *bitset.BitsetUint64, i int) {
+	if n := i/64 + 1 - len(*b); n > 0 {
+		*b = append(*b, bitset.NewUint64(n*64)...)
+	}
+	b.SetBit(i)
+}
+
+// clearBit clears the bit, where bits past the end are already unset.
+func clearBit(b bitset.BitsetUint64, i int) {
+	if i < b.Len() {
+		b.ClearBit(i)
+	}
+}
@@ -0,0 +1,176 @@
+package ecs_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jdavasligil/go-ecs"
+	"github.com/jdavasligil/go-ecs/pkg/testutil"
+)
+
+func TestTag(t *testing.T) {
+	world := ecs.NewWorld(ecs.WorldOptions{
+		EntityLimit:    1024,
+		RecycleLimit:   1024,
+		ComponentLimit: 255,
+	})
+	ecs.Initialize[Position](&world)
+	ecs.Initialize[Health](&world)
+	ecs.Initialize[CombatTag](&world)
+	ecs.Initialize[DeadTag](&world)
+	testutil.AssertEqual(t, ecs.SetStorage[CombatTag](&world, ecs.TagStorage), true)
+	testutil.AssertEqual(t, ecs.SetStorage[DeadTag](&world, ecs.TagStorage), true)
+
+	entities := make([]ecs.Entity, 300)
+	for i := range entities {
+		entities[i] = world.NewEntity()
+		ecs.Add(&world, entities[i], Position{x: float32(i)})
+	}
+	// Tags are added out of order and span several words of the bitset.
+	var tagged []ecs.Entity
+	for i := len(entities) - 1; i >= 0; i-- {
+		if i%3 == 0 {
+			testutil.AssertEqual(t, ecs.Add(&world, entities[i], CombatTag{}), true)
+		}
+	}
+	for i, e := range entities {
+		if i%3 == 0 {
+			tagged = append(tagged, e)
+		}
+	}
+
+	t.Run("Membership", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.Add(&world, entities[0], CombatTag{}), false)
+		for i, e := range entities {
+			_, ok := ecs.Get[CombatTag](&world, e)
+			testutil.AssertEqual(t, ok, i%3 == 0)
+			testutil.AssertEqual(t, world.Store(CombatTagID).Has(e), i%3 == 0)
+		}
+
+		// Tags are packed in order of entity ID.
+		es, cs := ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, tagged), true)
+		testutil.AssertEqual(t, len(cs), len(tagged))
+		testutil.AssertEqual(t, world.Store(CombatTagID).Len(), len(tagged))
+
+		n := 0
+		for e, c := range ecs.Each2[Position, CombatTag](&world) {
+			testutil.AssertEqual(t, e, entities[int(c.A.x)])
+			testutil.AssertEqual(t, int(c.A.x)%3, 0)
+			n++
+		}
+		testutil.AssertEqual(t, n, len(tagged))
+
+		ecs.Add(&world, entities[1], Health{})
+		ecs.Add(&world, entities[3], Health{})
+		es = ecs.Query2[Health, CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{entities[3]}), true)
+	})
+
+	t.Run("Exclude", func(t *testing.T) {
+		es := ecs.QueryExclude[Position, CombatTag](&world)
+		testutil.AssertEqual(t, len(es), len(entities)-len(tagged))
+		for _, e := range es {
+			testutil.AssertEqual(t, world.Store(CombatTagID).Has(e), false)
+		}
+		n := 0
+		for range ecs.Each2Exclude1[Position, Health, CombatTag](&world) {
+			n++
+		}
+		testutil.AssertEqual(t, n, 1)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		last := world.Tick()
+		world.Advance()
+		testutil.AssertEqual(t, ecs.Remove[CombatTag](&world, entities[3]), true)
+		testutil.AssertEqual(t, ecs.Remove[CombatTag](&world, entities[3]), false)
+		testutil.AssertEqual(t, world.DestroyEntity(entities[6]), true)
+		tagged = slices.DeleteFunc(tagged, func(e ecs.Entity) bool {
+			return e == entities[3] || e == entities[6]
+		})
+
+		es, _ := ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, tagged), true)
+		testutil.AssertEqual(t, len(ecs.Query2[Health, CombatTag](&world)), 0)
+		testutil.AssertEqual(t, ecs.Removed[CombatTag](&world, last)(entities[3]), true)
+
+		// A recycled ID is not tagged until the tag is added again.
+		e := world.NewEntity()
+		testutil.AssertEqual(t, e.ID(), entities[6].ID())
+		testutil.AssertEqual(t, world.Store(CombatTagID).Has(e), false)
+		ecs.Add(&world, e, CombatTag{})
+		es, _ = ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Contains(es, e), true)
+		testutil.AssertEqual(t, slices.Contains(es, entities[6]), false)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		before, _ := ecs.Query[CombatTag](&world)
+		before = slices.Clone(before)
+
+		// Tags are disabled out of order and stay in order of entity ID.
+		for _, i := range []int{9, 0, 15} {
+			testutil.AssertEqual(t, ecs.Disable[CombatTag](&world, entities[i]), true)
+		}
+		off := []ecs.Entity{entities[0], entities[9], entities[15]}
+		es, _ := ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, slices.DeleteFunc(slices.Clone(before), func(e ecs.Entity) bool {
+			return slices.Contains(off, e)
+		})), true)
+		es, _ = ecs.Disabled[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, off), true)
+
+		for _, i := range []int{15, 0, 9} {
+			testutil.AssertEqual(t, ecs.Enable[CombatTag](&world, entities[i]), true)
+		}
+		es, _ = ecs.Query[CombatTag](&world)
+		testutil.AssertEqual(t, slices.Equal(es, before), true)
+	})
+
+	t.Run("Ticks", func(t *testing.T) {
+		last := world.Tick()
+		world.Advance()
+		e := world.NewEntity()
+		ecs.Add(&world, e, DeadTag{})
+		testutil.AssertEqual(t, ecs.Added[DeadTag](&world, last)(e), false)
+		testutil.AssertEqual(t, ecs.MarkChanged[DeadTag](&world, e), false)
+		testutil.AssertEqual(t, ecs.Changed[DeadTag](&world, last)(e), false)
+	})
+
+	t.Run("MemUsage", func(t *testing.T) {
+		// The bitset takes a bit per entity ID, less than a page of indices.
+		testutil.AssertEqual(t, ecs.MemUsage[CombatTag](&world) < ecs.MemUsage[Position](&world)/8, true)
+	})
+
+	t.Run("SetStorage", func(t *testing.T) {
+		testutil.AssertEqual(t, ecs.SetStorage[Velocity](&world, ecs.DenseStorage), false)
+		testutil.AssertEqual(t, ecs.SetStorage[DeadTag](&world, ecs.DenseStorage), false)
+
+		other := ecs.NewWorld(ecs.WorldOptions{
+			EntityLimit:    1024,
+			RecycleLimit:   1024,
+			ComponentLimit: 255,
+		})
+		ecs.Initialize[Position](&other)
+		ecs.Initialize[DeadTag](&other)
+		testutil.AssertEqual(t, ecs.SetStorage[Position](&other, ecs.TagStorage), false)
+		testutil.AssertEqual(t, ecs.SetStorage[DeadTag](&other, ecs.TagStorage), true)
+		testutil.AssertEqual(t, ecs.SetStorage[DeadTag](&other, ecs.DenseStorage), true)
+
+		// Dense tags record ticks and can be owned by a group.
+		last := other.Tick()
+		other.Advance()
+		e := other.NewEntity()
+		ecs.Add(&other, e, Position{})
+		ecs.Add(&other, e, DeadTag{})
+		testutil.AssertEqual(t, ecs.Added[DeadTag](&other, last)(e), true)
+		es, _, _ := ecs.Group2[Position, DeadTag](&other)
+		testutil.AssertEqual(t, slices.Equal(es, []ecs.Entity{e}), true)
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		es, ps, cs := ecs.Group2[Position, CombatTag](&world)
+		testutil.AssertEqual(t, es == nil && ps == nil && cs == nil, true)
+	})
+}